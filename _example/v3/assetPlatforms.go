@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	gecko "github.com/edward-yakop/go-gecko/v3"
+	"github.com/edward-yakop/go-gecko/v3/types"
+)
+
+func main() {
+	cg := gecko.NewClient(nil)
+	ap, err := cg.AssetPlatforms(types.AssetPlatformsFilterNone)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for chainID, platformID := range ap.PlatformIDsByChainID() {
+		fmt.Println(chainID, platformID)
+	}
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	gecko "github.com/edward-yakop/go-gecko/v3"
+	"github.com/edward-yakop/go-gecko/v3/types"
+)
+
+func main() {
+	cg := gecko.NewClient(nil)
+	list, err := cg.CoinsCategoriesList()
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("Categories:", len(list.Categories))
+
+	categories, err := cg.CoinsCategories(types.CoinsCategoriesOrderMarketCapDesc)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, category := range categories.Categories {
+		if category.MarketCap != nil {
+			fmt.Printf("%s: %.02f\n", category.Name, *category.MarketCap)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	gecko "github.com/edward-yakop/go-gecko/v3"
+)
+
+func main() {
+	cg := gecko.NewClient(nil)
+	coin, err := cg.CoinsIDContractAddress(gecko.CoinsIDContractAddressParams{
+		AssetPlatformID: "ethereum",
+		ContractAddress: "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(coin.ID, coin.Symbol, coin.Name)
+	for platform, detail := range coin.DetailPlatforms {
+		if detail.DecimalPlace != nil {
+			fmt.Printf("%s: %s (%d decimals)\n", platform, detail.ContractAddress, *detail.DecimalPlace)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"log"
+	"time"
 
 	gecko "github.com/edward-yakop/go-gecko/v3"
 )
@@ -11,7 +12,7 @@ func main() {
 	cg := gecko.NewClient(nil)
 	btc, err := cg.CoinsIDHistory(gecko.CoinsIDHistoryParams{
 		CoinID:       "bitcoin",
-		SnapshotDate: "30-12-2018",
+		SnapshotDate: time.Date(2018, time.December, 30, 0, 0, 0, 0, time.UTC),
 		Localization: true,
 	})
 	if err != nil {
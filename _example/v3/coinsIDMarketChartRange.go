@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	gecko "github.com/edward-yakop/go-gecko/v3"
+	"github.com/edward-yakop/go-gecko/v3/types"
+)
+
+func main() {
+	cg := gecko.NewClient(nil)
+	to := time.Now()
+	m, err := cg.CoinsIDMarketChartRange(gecko.CoinsIDMarketChartRangeParams{
+		CoinsID:     "bitcoin",
+		VsCurrency:  "usd",
+		From:        to.AddDate(0, 0, -7),
+		To:          to,
+		Granularity: types.MarketChartGranularityHourly,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("Prices\n")
+	for _, v := range m.Prices {
+		fmt.Printf("%s:%.04f\n", v.Time.String(), v.Value)
+	}
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	gecko "github.com/edward-yakop/go-gecko/v3"
+)
+
+func main() {
+	cg := gecko.NewClient(nil)
+	ohlc, err := cg.CoinsIDOHLC(gecko.CoinsIDOHLCParams{
+		CoinsID:    "bitcoin",
+		VsCurrency: "usd",
+		Days:       "1",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, v := range ohlc.Candles {
+		fmt.Printf("%s: O %.02f H %.02f L %.02f C %.02f\n", v.Time.String(), v.Open, v.High, v.Low, v.Close)
+	}
+}
@@ -9,7 +9,7 @@ import (
 
 func main() {
 	cg := gecko.NewClient(nil)
-	list, err := cg.CoinsList()
+	list, err := cg.CoinsList(gecko.CoinsListParams{})
 	if err != nil {
 		log.Fatal(err)
 	}
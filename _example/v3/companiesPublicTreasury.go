@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	gecko "github.com/edward-yakop/go-gecko/v3"
+)
+
+func main() {
+	cg := gecko.NewClient(nil)
+	pt, err := cg.CompaniesPublicTreasury("bitcoin")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("Total holdings: %f (%f USD)\n", pt.TotalHoldings, pt.TotalValueUsd)
+	for _, company := range pt.Companies {
+		fmt.Printf("%s: %f\n", company.Name, company.TotalHoldings)
+	}
+}
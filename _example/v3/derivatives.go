@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	gecko "github.com/edward-yakop/go-gecko/v3"
+	"github.com/edward-yakop/go-gecko/v3/types"
+)
+
+func main() {
+	cg := gecko.NewClient(nil)
+	exchanges, err := cg.DerivativesExchanges(gecko.DerivativesExchangesParams{
+		Order:    types.DerivativesExchangesOrderOpenInterestBtcDesc,
+		PageSize: 10,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, exchange := range exchanges.Exchanges {
+		fmt.Println(exchange.ID, exchange.Name)
+	}
+
+	detail, err := cg.DerivativesExchangesID(gecko.DerivativesExchangesIDParams{
+		ExchangeID:     "binance_futures",
+		IncludeTickers: types.DerivativesIncludeTickersUnexpired,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, ticker := range detail.Tickers {
+		if ticker.FundingRate != nil {
+			fmt.Printf("%s: funding rate %f\n", ticker.Symbol, *ticker.FundingRate)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	gecko "github.com/edward-yakop/go-gecko/v3"
+)
+
+func main() {
+	cg := gecko.NewClient(nil)
+	vc, err := cg.ExchangesIDVolumeChart(gecko.ExchangesIDVolumeChartParams{
+		ExchangeID: "binance",
+		Days:       "1",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, v := range vc.Volumes {
+		fmt.Printf("%s:%.04f BTC\n", v.Time.String(), v.Value)
+	}
+}
@@ -3,7 +3,6 @@ package main
 import (
 	"fmt"
 	"log"
-	"time"
 
 	gecko "github.com/edward-yakop/go-gecko/v3"
 )
@@ -22,5 +21,5 @@ func main() {
 	fmt.Println("Global Total Market Cap in USD:", global.TotalMarketCap["usd"])
 	fmt.Println("Market Cap Percentage of ETH:", global.MarketCapPercentage["eth"])
 	fmt.Println("Market Cap Change Percentage 24h USD:", global.MarketCapChangePercentage24hUSD)
-	fmt.Println("last updated:", time.Unix(global.UpdatedAt, 0))
+	fmt.Println("last updated:", global.UpdatedAt.Time)
 }
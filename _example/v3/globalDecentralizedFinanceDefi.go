@@ -0,0 +1,20 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	gecko "github.com/edward-yakop/go-gecko/v3"
+)
+
+func main() {
+	cg := gecko.NewClient(nil)
+	defi, err := cg.GlobalDecentralizedFinanceDefi()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println("DeFi market cap:", defi.DefiMarketCap)
+	fmt.Println("DeFi dominance:", defi.DefiDominance)
+	fmt.Println("Top coin:", defi.TopCoinName)
+}
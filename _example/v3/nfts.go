@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	gecko "github.com/edward-yakop/go-gecko/v3"
+	"github.com/edward-yakop/go-gecko/v3/types"
+)
+
+func main() {
+	cg := gecko.NewClient(nil)
+	list, err := cg.NFTsList(gecko.NFTsListParams{
+		Order:    types.NFTsListOrderMarketCapUsdDesc,
+		PageSize: 10,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, item := range list.NFTs {
+		fmt.Println(item.ID, item.Name)
+	}
+
+	nft, err := cg.NFTsID("pudgy-penguins")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if nft.FloorPrice.NativeCurrency != nil {
+		fmt.Printf("%s floor price: %f %s\n", nft.Name, *nft.FloorPrice.NativeCurrency, nft.NativeCurrencySymbol)
+	}
+}
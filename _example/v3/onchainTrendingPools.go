@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	gecko "github.com/edward-yakop/go-gecko/v3"
+	"github.com/edward-yakop/go-gecko/v3/onchain"
+)
+
+func main() {
+	cg := onchain.NewClient(gecko.NewClient(nil))
+	pools, err := cg.NetworksTrendingPools(onchain.PoolsParams{
+		Network: "eth",
+		Include: []onchain.PoolInclude{onchain.PoolIncludeBaseToken, onchain.PoolIncludeDex},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, pool := range pools.Pools {
+		fmt.Println(pool.Name, pool.DexID, pool.BaseToken.Symbol)
+	}
+}
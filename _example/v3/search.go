@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	gecko "github.com/edward-yakop/go-gecko/v3"
+)
+
+func main() {
+	cg := gecko.NewClient(nil)
+	s, err := cg.Search("ethereum")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, coin := range s.Coins {
+		fmt.Println(coin.ID, coin.Symbol, coin.Name)
+	}
+
+	for _, exchange := range s.Exchanges {
+		fmt.Println(exchange.ID, exchange.Name)
+	}
+}
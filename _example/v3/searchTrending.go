@@ -0,0 +1,20 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	gecko "github.com/edward-yakop/go-gecko/v3"
+)
+
+func main() {
+	cg := gecko.NewClient(nil)
+	t, err := cg.SearchTrending()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, coin := range t.Coins {
+		fmt.Println(coin.Item.Score, coin.Item.ID, coin.Item.Name)
+	}
+}
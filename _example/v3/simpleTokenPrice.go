@@ -0,0 +1,27 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	gecko "github.com/edward-yakop/go-gecko/v3"
+)
+
+func main() {
+	cg := gecko.NewClient(nil)
+	tp, err := cg.SimpleTokenPrice(gecko.SimpleTokenPriceParams{
+		AssetPlatformID:   "ethereum",
+		ContractAddresses: []string{"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"},
+		VsCurrencies:      []string{"usd", "eth"},
+		LastUpdatedAt:     true,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for address, token := range tp.Tokens {
+		for currency, v := range token.Currencies {
+			fmt.Printf("%s: %f %s (%s)\n", address, v.Price, currency, token.LastUpdatedAt)
+		}
+	}
+}
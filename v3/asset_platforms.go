@@ -0,0 +1,37 @@
+package coingecko
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/edward-yakop/go-gecko/v3/types"
+	"net/url"
+)
+
+// AssetPlatforms /asset_platforms?filter={filter}
+func (c *Client) AssetPlatforms(filter types.AssetPlatformsFilter) (*types.AssetPlatforms, error) {
+	if filter < types.AssetPlatformsFilterNone || filter > types.AssetPlatformsFilterNFT {
+		return nil, fmt.Errorf("invalid filter %d", filter)
+	}
+
+	assetPlatformsURL := fmt.Sprintf("%s/asset_platforms", c.baseURL)
+	if filter != types.AssetPlatformsFilterNone {
+		params := url.Values{}
+		params.Add("filter", filter.String())
+		assetPlatformsURL += "?" + params.Encode()
+	}
+
+	resp, header, err := c.makeHTTPRequest(assetPlatformsURL)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &types.AssetPlatforms{
+		BaseResult: types.NewBaseResult(header),
+		Platforms:  []types.AssetPlatformItem{},
+	}
+	if err = json.Unmarshal(resp, &data.Platforms); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,43 @@
+package coingecko
+
+import (
+	"github.com/edward-yakop/go-gecko/v3/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestClient_AssetPlatforms(t *testing.T) {
+	err := setupGock("json/asset_platforms.json", "json/common.headers.json", "/asset_platforms")
+	require.NoError(t, err)
+
+	got, err := c.AssetPlatforms(types.AssetPlatformsFilterNone)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+
+	require.Len(t, got.Platforms, 5)
+
+	bsc := got.Platforms[1]
+	assert.Equal(t, "binance-smart-chain", bsc.ID, "bsc.ID")
+	assert.Equal(t, int64(56), *bsc.ChainIdentifier, "bsc.ChainIdentifier")
+	assert.Equal(t, "BNB Smart Chain", bsc.Name, "bsc.Name")
+	assert.Equal(t, "BSC", bsc.Shortname, "bsc.Shortname")
+	assert.Equal(t, "binancecoin", *bsc.NativeCoinID, "bsc.NativeCoinID")
+
+	solana := got.Platforms[3]
+	assert.Nil(t, solana.ChainIdentifier, "solana.ChainIdentifier")
+	assert.Nil(t, got.Platforms[4].NativeCoinID, "factom.NativeCoinID")
+
+	assert.Equal(t, map[int64]string{
+		1:   "ethereum",
+		56:  "binance-smart-chain",
+		137: "polygon-pos",
+	}, got.PlatformIDsByChainID())
+}
+
+func TestClient_AssetPlatforms_invalidFilter(t *testing.T) {
+	_, err := c.AssetPlatforms(types.AssetPlatformsFilter(5))
+	assert.Error(t, err)
+}
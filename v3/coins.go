@@ -2,16 +2,56 @@ package coingecko
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"github.com/buger/jsonparser"
 	"github.com/edward-yakop/go-gecko/format"
 	"github.com/edward-yakop/go-gecko/v3/types"
+	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
+type CoinsListParams struct {
+	IncludePlatform bool                  `json:"include_platform"` // Sets to true to include the contract address of every asset platform
+	Status          types.CoinsListStatus `json:"status"`           // Default to CoinsListStatusActive. CoinsListStatusInactive lists delisted coins.
+}
+
+func (p CoinsListParams) Valid() error {
+	if !p.Status.Valid() {
+		return fmt.Errorf("invalid Status %d", p.Status)
+	}
+
+	return nil
+}
+
+func (p CoinsListParams) encodeQueryParams() string {
+	params := url.Values{}
+
+	if p.IncludePlatform {
+		params.Add("include_platform", format.Bool2String(p.IncludePlatform))
+	}
+
+	if p.Status != types.CoinsListStatusActive {
+		params.Add("status", p.Status.String())
+	}
+
+	return params.Encode()
+}
+
 // CoinsList /coins/list
-func (c *Client) CoinsList() (*types.CoinsList, error) {
+func (c *Client) CoinsList(params CoinsListParams) (*types.CoinsList, error) {
+	if err := params.Valid(); err != nil {
+		return nil, err
+	}
+
 	coinsListURL := fmt.Sprintf("%s/coins/list", c.baseURL)
+	if query := params.encodeQueryParams(); query != "" {
+		coinsListURL += "?" + query
+	}
 	resp, header, err := c.makeHTTPRequest(coinsListURL)
 	if err != nil {
 		return nil, err
@@ -28,15 +68,165 @@ func (c *Client) CoinsList() (*types.CoinsList, error) {
 	return data, nil
 }
 
+// CoinsListNew /coins/list/new. Paid plan only.
+func (c *Client) CoinsListNew() (*types.CoinsListNew, error) {
+	if err := c.requirePro("/coins/list/new"); err != nil {
+		return nil, err
+	}
+
+	coinsListNewURL := fmt.Sprintf("%s/coins/list/new", c.baseURL)
+	resp, header, err := c.makeHTTPRequest(coinsListNewURL)
+	if err != nil {
+		return nil, toPlanRestrictedError(err, "/coins/list/new")
+	}
+
+	var data = &types.CoinsListNew{
+		BaseResult: types.NewBaseResult(header),
+		Coins:      []types.CoinsListNewItem{},
+	}
+	if err = json.Unmarshal(resp, &data.Coins); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+type CoinsTopGainersLosersParams struct {
+	VsCurrency string                         `json:"vs_currency"` // Required. The target currency of market data (usd, eur, jpy, etc.)
+	Duration   types.TopGainersLosersDuration `json:"duration"`    // Default to TopGainersLosersDuration24H
+	TopCoins   types.TopGainersLosersTopCoins `json:"top_coins"`   // Filter by market cap ranking. Default to TopGainersLosersTopCoins1000
+}
+
+func (p CoinsTopGainersLosersParams) Validate() error {
+	if p.VsCurrency == "" {
+		return fmt.Errorf("VsCurrency is required")
+	}
+
+	if !p.Duration.Valid() {
+		return fmt.Errorf("invalid Duration %d", p.Duration)
+	}
+
+	if !p.TopCoins.Valid() {
+		return fmt.Errorf("invalid TopCoins %d", p.TopCoins)
+	}
+
+	return nil
+}
+
+func (p CoinsTopGainersLosersParams) encodeQueryParams() string {
+	params := url.Values{}
+
+	params.Add("vs_currency", p.VsCurrency)
+	params.Add("duration", p.Duration.String())
+	params.Add("top_coins", p.TopCoins.String())
+
+	return params.Encode()
+}
+
+// CoinsTopGainersLosers /coins/top_gainers_losers. Paid plan only.
+func (c *Client) CoinsTopGainersLosers(params CoinsTopGainersLosersParams) (*types.CoinsTopGainersLosers, error) {
+	if err := c.requirePro("/coins/top_gainers_losers"); err != nil {
+		return nil, err
+	}
+
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	topGainersLosersURL := fmt.Sprintf("%s/coins/top_gainers_losers?%s", c.baseURL, params.encodeQueryParams())
+	resp, header, err := c.makeHTTPRequest(topGainersLosersURL)
+	if err != nil {
+		return nil, toPlanRestrictedError(err, "/coins/top_gainers_losers")
+	}
+
+	data := &types.CoinsTopGainersLosers{
+		BaseResult: types.NewBaseResult(header),
+	}
+	if data.TopGainers, err = params.parseItems(resp, "top_gainers"); err != nil {
+		return nil, err
+	}
+	if data.TopLosers, err = params.parseItems(resp, "top_losers"); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// parseItems parses the items, whose price related keys are prefixed by the vs currency, e.g. "usd", "usd_24h_vol"
+// and "usd_24h_change".
+func (p CoinsTopGainersLosersParams) parseItems(resp []byte, key string) ([]types.TopGainersLosersItem, error) {
+	vsCurrency := strings.ToLower(p.VsCurrency)
+	volumeKey := vsCurrency + "_24h_vol"
+	changeKey := vsCurrency + "_" + p.Duration.String() + "_change"
+
+	r := []types.TopGainersLosersItem{}
+	var err error
+	_, _ = jsonparser.ArrayEach(resp, func(ba []byte, _ jsonparser.ValueType, _ int, pErr error) {
+		hasError := err != nil || pErr != nil
+		if hasError {
+			err = firstError(err, pErr)
+			return
+		}
+
+		var item types.TopGainersLosersItem
+		if err = json.Unmarshal(ba, &item); err != nil {
+			return
+		}
+
+		err = jsonparser.ObjectEach(ba, func(keyBA []byte, ba []byte, dataType jsonparser.ValueType, _ int) error {
+			k := string(keyBA)
+			if dataType != jsonparser.Number || (k != vsCurrency && k != volumeKey && k != changeKey) {
+				return nil
+			}
+
+			v, pErr := jsonparser.ParseFloat(ba)
+			if pErr != nil {
+				return fmt.Errorf("error parsing %s.%s = %s: %v", item.ID, k, string(ba), pErr)
+			}
+
+			switch k {
+			case vsCurrency:
+				item.Price = &v
+			case volumeKey:
+				item.Volume24h = &v
+			case changeKey:
+				item.PriceChangePercentage = &v
+			}
+
+			return nil
+		})
+		if err == nil {
+			r = append(r, item)
+		}
+	}, key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
 type CoinsMarketParams struct {
-	VsCurrency            string                        `json:"vs_currency"` // Required. The target currency of market data (usd, eur, jpy, etc.)
-	CoinIDs               []string                      `json:"coin_ids"`    // The ids of the coin, crytocurrency symbols (base). refers to /coins/list.
-	Category              string                        `json:"category"`    // filter by coin category. Refer to /coin/categories/list
-	Order                 types.CoinsMarketOrder        `json:"order"`       // When blank will be set to "market_cap_desc"
-	PageSize              int                           `json:"page_size"`   // Starts from 1 - 250, when invalid will be set to 100
-	PageNo                int                           `json:"page_no"`     // Starts from 1, when < 1, will be set to 1
-	Sparkline             bool                          `json:"sparkline"`   // Include sparkline 7 days data (eg. true, false)
+	VsCurrency            string                        `json:"vs_currency"`        // Required. The target currency of market data (usd, eur, jpy, etc.)
+	CoinIDs               []string                      `json:"coin_ids"`           // The ids of the coin, crytocurrency symbols (base). refers to /coins/list.
+	Names                 []string                      `json:"names"`              // The names of the coin, e.g. Bitcoin. Ignored when CoinIDs is set.
+	Symbols               []string                      `json:"symbols"`            // The symbols of the coin, e.g. btc. Ignored when CoinIDs or Names is set.
+	IncludeAllTokens      bool                          `json:"include_all_tokens"` // Sets to true to return all coins sharing one of the Symbols, instead of the top ranked one only
+	Category              string                        `json:"category"`           // filter by coin category. Refer to CoinsCategoriesList
+	Order                 types.CoinsMarketOrder        `json:"order"`              // Default to CoinMarketOrderMarketCapDesc
+	PageSize              int                           `json:"page_size"`          // 1 - 250, when 0 default to 100
+	PageNo                int                           `json:"page_no"`            // Starts from 1, when 0 default to 1
+	Sparkline             bool                          `json:"sparkline"`          // Include sparkline 7 days data (eg. true, false)
 	PriceChangePercentage []types.PriceChangePercentage `json:"price_change_percentage"`
+	Locale                string                        `json:"locale"`    // Language of the coin names, e.g. en, de, ja. When empty, default to en.
+	Precision             string                        `json:"precision"` // Decimal place for currency price value. Either "full" or int [0,18]. When empty, default precision.
+}
+
+// coinsMarketLocales supported /coins/markets locales
+var coinsMarketLocales = []string{
+	"ar", "bg", "cs", "da", "de", "el", "en", "es", "fi", "fr", "he", "hi", "hr", "hu", "id", "it", "ja", "ko", "lt",
+	"nl", "no", "pl", "pt", "ro", "ru", "sk", "sl", "sv", "th", "tr", "uk", "vi", "zh", "zh-tw",
 }
 
 func (p CoinsMarketParams) Validate() error {
@@ -44,7 +234,39 @@ func (p CoinsMarketParams) Validate() error {
 		return fmt.Errorf("VsCurrency is required")
 	}
 
-	return nil
+	if !p.Order.Valid() {
+		return fmt.Errorf("invalid Order %d", p.Order)
+	}
+
+	if p.PageSize < 0 || p.PageSize > 250 {
+		return fmt.Errorf("PageSize must be between 1 and 250, got %d", p.PageSize)
+	}
+
+	if p.PageNo < 0 {
+		return fmt.Errorf("PageNo must start from 1, got %d", p.PageNo)
+	}
+
+	for _, pcp := range p.PriceChangePercentage {
+		if !pcp.Valid() {
+			return fmt.Errorf("invalid PriceChangePercentage %d", pcp)
+		}
+	}
+
+	if p.Locale != "" && !containsString(coinsMarketLocales, p.Locale) {
+		return fmt.Errorf("unsupported Locale %q", p.Locale)
+	}
+
+	return validatePrecision(p.Precision)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (p CoinsMarketParams) encodeQueryParams() string {
@@ -53,25 +275,35 @@ func (p CoinsMarketParams) encodeQueryParams() string {
 	params.Add("vs_currency", p.VsCurrency)
 
 	// order
-	if p.Order < 0 || p.Order > 5 {
-		p.Order = types.CoinMarketOrderMarketCapDesc
-	}
 	params.Add("order", p.Order.String())
 
-	// ids
+	// ids, names, symbols
 	if len(p.CoinIDs) != 0 {
-		idsParam := strings.Join(p.CoinIDs, ",")
-		params.Add("ids", idsParam)
+		params.Add("ids", strings.Join(p.CoinIDs, ","))
+	}
+	if len(p.Names) != 0 {
+		params.Add("names", strings.Join(p.Names, ","))
+	}
+	if len(p.Symbols) != 0 {
+		params.Add("symbols", strings.Join(p.Symbols, ","))
+		if p.IncludeAllTokens {
+			params.Add("include_tokens", "all")
+		}
+	}
+
+	// category
+	if p.Category != "" {
+		params.Add("category", p.Category)
 	}
 
 	// per_page
-	if p.PageSize <= 0 || p.PageSize > 250 {
+	if p.PageSize == 0 {
 		p.PageSize = 100
 	}
 	params.Add("per_page", format.Int2String(p.PageSize))
 
 	// PageNo
-	if p.PageNo <= 1 {
+	if p.PageNo == 0 {
 		p.PageNo = 1
 	}
 	params.Add("page", format.Int2String(p.PageNo))
@@ -82,17 +314,22 @@ func (p CoinsMarketParams) encodeQueryParams() string {
 	}
 
 	// price_change_percentage
-	pcpLen := len(p.PriceChangePercentage)
-	if pcpLen != 0 {
-		sb := strings.Builder{}
-		lastPcpIndex := pcpLen - 1
+	if len(p.PriceChangePercentage) != 0 {
+		pcps := make([]string, len(p.PriceChangePercentage))
 		for i, pcp := range p.PriceChangePercentage {
-			sb.WriteString(pcp.String())
-			if i != lastPcpIndex {
-				sb.WriteString(",")
-			}
+			pcps[i] = pcp.String()
 		}
-		params.Add("price_change_percentage", sb.String())
+		params.Add("price_change_percentage", strings.Join(pcps, ","))
+	}
+
+	// locale
+	if p.Locale != "" {
+		params.Add("locale", p.Locale)
+	}
+
+	// precision
+	if p.Precision != "" {
+		params.Add("precision", p.Precision)
 	}
 
 	return params.Encode()
@@ -122,13 +359,15 @@ func (c *Client) CoinsMarkets(params CoinsMarketParams) (*types.CoinsMarkets, er
 }
 
 type CoinsIDParams struct {
-	CoinID        string `json:"coin_id"`        // CoinID (can be obtained from /coins)
-	Localization  bool   `json:"localization"`   // Include all localized languages in response
-	Tickers       bool   `json:"tickers"`        // Include tickers data. If true returns up to 100 entries. Use CoinsIDTickers
-	MarketData    bool   `json:"market_data"`    // Include market data
-	CommunityData bool   `json:"community_data"` // Include community data
-	DeveloperData bool   `json:"developer_data"` // Include developer data
-	Sparkline     bool   `json:"sparkline"`      // Include sparkline 7 days data
+	CoinID                   string              `json:"coin_id"`                    // CoinID (can be obtained from /coins)
+	Localization             bool                `json:"localization"`               // Include all localized languages in response
+	Tickers                  bool                `json:"tickers"`                    // Include tickers data. If true returns up to 100 entries. Use CoinsIDTickers
+	MarketData               bool                `json:"market_data"`                // Include market data
+	CommunityData            bool                `json:"community_data"`             // Include community data
+	DeveloperData            bool                `json:"developer_data"`             // Include developer data
+	Sparkline                bool                `json:"sparkline"`                  // Include sparkline 7 days data
+	IncludeCategoriesDetails bool                `json:"include_categories_details"` // Include categories id and name
+	DexPairFormat            types.DexPairFormat `json:"dex_pair_format"`            // Format of DEX tickers base and target. Default to DexPairFormatContractAddress
 }
 
 func (c CoinsIDParams) Validate() error {
@@ -136,18 +375,42 @@ func (c CoinsIDParams) Validate() error {
 		return fmt.Errorf("id is required")
 	}
 
+	if !c.DexPairFormat.Valid() {
+		return fmt.Errorf("invalid DexPairFormat %d", c.DexPairFormat)
+	}
+
 	return nil
 }
 
+// encodeNonIDQueryParams only encodes values that differ from CoinGecko defaults. Localization, Tickers, MarketData,
+// CommunityData and DeveloperData default to true.
 func (c CoinsIDParams) encodeNonIDQueryParams() string {
 	params := url.Values{}
 
-	params.Add("localization", format.Bool2String(c.Localization))
-	params.Add("tickers", format.Bool2String(c.Tickers))
-	params.Add("market_data", format.Bool2String(c.MarketData))
-	params.Add("community_data", format.Bool2String(c.CommunityData))
-	params.Add("developer_data", format.Bool2String(c.DeveloperData))
-	params.Add("sparkline", format.Bool2String(c.Sparkline))
+	if !c.Localization {
+		params.Add("localization", format.Bool2String(c.Localization))
+	}
+	if !c.Tickers {
+		params.Add("tickers", format.Bool2String(c.Tickers))
+	}
+	if !c.MarketData {
+		params.Add("market_data", format.Bool2String(c.MarketData))
+	}
+	if !c.CommunityData {
+		params.Add("community_data", format.Bool2String(c.CommunityData))
+	}
+	if !c.DeveloperData {
+		params.Add("developer_data", format.Bool2String(c.DeveloperData))
+	}
+	if c.Sparkline {
+		params.Add("sparkline", format.Bool2String(c.Sparkline))
+	}
+	if c.IncludeCategoriesDetails {
+		params.Add("include_categories_details", format.Bool2String(c.IncludeCategoriesDetails))
+	}
+	if c.DexPairFormat != types.DexPairFormatContractAddress {
+		params.Add("dex_pair_format", c.DexPairFormat.String())
+	}
 
 	return params.Encode()
 }
@@ -158,7 +421,10 @@ func (c *Client) CoinsID(params CoinsIDParams) (*types.CoinsID, error) {
 		return nil, err
 	}
 
-	coinsURL := fmt.Sprintf("%s/coins/%s?%s", c.baseURL, params.CoinID, params.encodeNonIDQueryParams())
+	coinsURL := fmt.Sprintf("%s/coins/%s", c.baseURL, params.CoinID)
+	if query := params.encodeNonIDQueryParams(); query != "" {
+		coinsURL += "?" + query
+	}
 	resp, header, err := c.makeHTTPRequest(coinsURL)
 	if err != nil {
 		return nil, err
@@ -175,12 +441,13 @@ func (c *Client) CoinsID(params CoinsIDParams) (*types.CoinsID, error) {
 }
 
 type CoinsIDTickersParam struct {
-	CoinsID                string            `json:"coins_id"`                    // CoinID (can be obtained from /coins)
-	ExchangeIDs            []string          `json:"exchange_ids"`                // filter results by exchange_ids ExchangesList
-	ExchangeLogo           bool              `json:"exchange_logo"`               // flag to include exchange logo
-	PageNo                 int               `json:"page_no"`                     // Page through results
-	Order                  types.TickerOrder `json:"order"`                       // If not set default to trust_score_desc
-	Show2PctOrderBookDepth bool              `json:"show_2_pct_order_book_depth"` // flag to show 2% order book depth
+	CoinsID                string              `json:"coins_id"`                    // CoinID (can be obtained from /coins)
+	ExchangeIDs            []string            `json:"exchange_ids"`                // filter results by exchange_ids ExchangesList
+	ExchangeLogo           bool                `json:"exchange_logo"`               // flag to include exchange logo
+	PageNo                 int                 `json:"page_no"`                     // Page through results
+	Order                  types.TickerOrder   `json:"order"`                       // If not set default to trust_score_desc
+	Show2PctOrderBookDepth bool                `json:"show_2_pct_order_book_depth"` // flag to show 2% order book depth
+	DexPairFormat          types.DexPairFormat `json:"dex_pair_format"`             // Format of DEX tickers base and target. Default to DexPairFormatContractAddress
 }
 
 func (p CoinsIDTickersParam) Validate() error {
@@ -188,9 +455,18 @@ func (p CoinsIDTickersParam) Validate() error {
 		return fmt.Errorf("CoinsID is required")
 	}
 
+	if !p.Order.Valid() {
+		return fmt.Errorf("invalid Order %d", p.Order)
+	}
+
+	if !p.DexPairFormat.Valid() {
+		return fmt.Errorf("invalid DexPairFormat %d", p.DexPairFormat)
+	}
+
 	return nil
 }
 
+// encodeNonIDQueryParams only encodes values that differ from CoinGecko defaults
 func (p CoinsIDTickersParam) encodeNonIDQueryParams() string {
 	params := url.Values{}
 
@@ -198,15 +474,25 @@ func (p CoinsIDTickersParam) encodeNonIDQueryParams() string {
 		params.Add("exchange_ids", strings.Join(p.ExchangeIDs, ","))
 	}
 
-	params.Add("include_exchange_logo", format.Bool2String(p.ExchangeLogo))
+	if p.ExchangeLogo {
+		params.Add("include_exchange_logo", format.Bool2String(p.ExchangeLogo))
+	}
 
-	if p.PageNo < 1 {
-		p.PageNo = 1
+	if p.PageNo > 1 {
+		params.Add("page", format.Int2String(p.PageNo))
 	}
-	params.Add("page", format.Int2String(p.PageNo))
 
-	params.Add("order", p.Order.String())
-	params.Add("depth", format.Bool2String(p.Show2PctOrderBookDepth))
+	if p.Order != types.TickerOrderTrustScoreDesc {
+		params.Add("order", p.Order.String())
+	}
+
+	if p.Show2PctOrderBookDepth {
+		params.Add("depth", format.Bool2String(p.Show2PctOrderBookDepth))
+	}
+
+	if p.DexPairFormat != types.DexPairFormatContractAddress {
+		params.Add("dex_pair_format", p.DexPairFormat.String())
+	}
 
 	return params.Encode()
 }
@@ -217,7 +503,10 @@ func (c *Client) CoinsIDTickers(params CoinsIDTickersParam) (*types.CoinsIDTicke
 		return nil, err
 	}
 
-	coinsIDURL := fmt.Sprintf("%s/coins/%s/tickers?%s", c.baseURL, params.CoinsID, params.encodeNonIDQueryParams())
+	coinsIDURL := fmt.Sprintf("%s/coins/%s/tickers", c.baseURL, params.CoinsID)
+	if query := params.encodeNonIDQueryParams(); query != "" {
+		coinsIDURL += "?" + query
+	}
 	resp, header, err := c.makeHTTPRequest(coinsIDURL)
 	if err != nil {
 		return nil, err
@@ -234,9 +523,10 @@ func (c *Client) CoinsIDTickers(params CoinsIDTickersParam) (*types.CoinsIDTicke
 }
 
 type CoinsIDHistoryParams struct {
-	CoinID       string `json:"coin_id"`       // CoinID (can be obtained from /coins)
-	SnapshotDate string `json:"snapshot_date"` // The date of data snapshot in dd-mm-yyyy eg. 30-12-2017
-	Localization bool   `json:"localization"`  // Set to false to exclude localized languages in response
+	CoinID       string    `json:"coin_id"`       // CoinID (can be obtained from /coins)
+	SnapshotDate time.Time `json:"snapshot_date"` // The date of data snapshot. Only the UTC date part is used.
+	Localization bool      `json:"localization"`  // Set to false to exclude localized languages in response
+	GenesisDate  time.Time `json:"genesis_date"`  // Optional coin genesis date (see CoinsID), to reject snapshot before it
 }
 
 func (p CoinsIDHistoryParams) Validate() error {
@@ -244,22 +534,45 @@ func (p CoinsIDHistoryParams) Validate() error {
 		return fmt.Errorf("CoinID is required")
 	}
 
-	if p.SnapshotDate == "" {
+	if p.SnapshotDate.IsZero() {
 		return fmt.Errorf("SnapshotDate is required")
 	}
 
-	return nil
+	return validateSnapshotDate(p.SnapshotDate, p.GenesisDate)
 }
 
 func (p CoinsIDHistoryParams) encodeNonIDQueryParams() string {
 	params := url.Values{}
 
-	params.Add("date", p.SnapshotDate)
+	params.Add("date", p.SnapshotDate.UTC().Format(snapshotDateLayout))
 	params.Add("localization", format.Bool2String(p.Localization))
 
 	return params.Encode()
 }
 
+const snapshotDateLayout = "02-01-2006"
+
+// toUTCDay truncates t to the start of its UTC day
+func toUTCDay(t time.Time) time.Time {
+	u := t.UTC()
+
+	return time.Date(u.Year(), u.Month(), u.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func validateSnapshotDate(date, genesisDate time.Time) error {
+	day := toUTCDay(date)
+	if day.After(toUTCDay(time.Now())) {
+		return fmt.Errorf("snapshot date %s is in the future", day.Format(snapshotDateLayout))
+	}
+
+	if !genesisDate.IsZero() && day.Before(toUTCDay(genesisDate)) {
+		return fmt.Errorf("snapshot date %s is before genesis date %s", day.Format(snapshotDateLayout),
+			toUTCDay(genesisDate).Format(snapshotDateLayout))
+	}
+
+	return nil
+}
+
 // CoinsIDHistory /coins/{id}/history?date={date}&localization=false
 func (c *Client) CoinsIDHistory(params CoinsIDHistoryParams) (*types.CoinsIDHistory, error) {
 	if err := params.Validate(); err != nil {
@@ -282,10 +595,186 @@ func (c *Client) CoinsIDHistory(params CoinsIDHistoryParams) (*types.CoinsIDHist
 	return data, nil
 }
 
+type CoinsIDHistoryRangeParams struct {
+	CoinID       string        `json:"coin_id"`      // CoinID (can be obtained from /coins)
+	From         time.Time     `json:"from"`         // First snapshot date, inclusive. Only the UTC date part is used.
+	To           time.Time     `json:"to"`           // Last snapshot date, inclusive. Only the UTC date part is used.
+	Localization bool          `json:"localization"` // Set to false to exclude localized languages in response
+	GenesisDate  time.Time     `json:"genesis_date"` // Optional coin genesis date (see CoinsID), to reject range starting before it
+	Concurrency  int           `json:"concurrency"`  // Maximum number of requests in flight. When < 1, default to 1.
+	RetryAfter   time.Duration `json:"retry_after"`  // Wait after a rate limited (429) response without Retry-After header. When <= 0, default to 1 minute.
+	MaxRetries   int           `json:"max_retries"`  // Maximum rate limited retries per snapshot. When < 1, default to 3.
+
+	RequestsPerMinute int `json:"requests_per_minute"` // Spaces out requests to stay within the plan rate limit. When < 1, requests are only paused once rate limited.
+}
+
+func (p CoinsIDHistoryRangeParams) Validate() error {
+	if p.CoinID == "" {
+		return fmt.Errorf("CoinID is required")
+	}
+
+	if p.From.IsZero() || p.To.IsZero() {
+		return fmt.Errorf("From and To are required")
+	}
+
+	if toUTCDay(p.From).After(toUTCDay(p.To)) {
+		return fmt.Errorf("From must not be after To")
+	}
+
+	return firstError(validateSnapshotDate(p.From, p.GenesisDate), validateSnapshotDate(p.To, p.GenesisDate))
+}
+
+// CoinsIDHistoryRange fetches the CoinsIDHistory daily snapshots from params.From to params.To, ordered by date.
+// Requests are made concurrently, up to params.Concurrency, and spaced out by params.RequestsPerMinute. When rate
+// limited, all requests pause before retrying.
+func (c *Client) CoinsIDHistoryRange(params CoinsIDHistoryRangeParams) ([]*types.CoinsIDHistory, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if params.Concurrency < 1 {
+		params.Concurrency = 1
+	}
+	if params.RetryAfter <= 0 {
+		params.RetryAfter = time.Minute
+	}
+	if params.MaxRetries < 1 {
+		params.MaxRetries = 3
+	}
+
+	from := toUTCDay(params.From)
+	days := int(toUTCDay(params.To).Sub(from)/(24*time.Hour)) + 1
+
+	limiter := &rateLimiter{}
+	if params.RequestsPerMinute > 0 {
+		limiter.interval = time.Minute / time.Duration(params.RequestsPerMinute)
+	}
+	snapshots := make([]*types.CoinsIDHistory, days)
+	errs := make([]error, days)
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < params.Concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if limiter.failed() {
+					continue
+				}
+				snapshots[i], errs[i] = c.coinsIDHistoryWithRetry(CoinsIDHistoryParams{
+					CoinID:       params.CoinID,
+					SnapshotDate: from.AddDate(0, 0, i),
+					Localization: params.Localization,
+				}, limiter, params.RetryAfter, params.MaxRetries)
+			}
+		}()
+	}
+
+	for i := 0; i < days; i++ {
+		if limiter.failed() {
+			break
+		}
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return snapshots, nil
+}
+
+func (c *Client) coinsIDHistoryWithRetry(params CoinsIDHistoryParams, limiter *rateLimiter, retryAfter time.Duration, maxRetries int) (*types.CoinsIDHistory, error) {
+	for retry := 0; ; retry++ {
+		limiter.wait()
+
+		data, err := c.CoinsIDHistory(params)
+		if err == nil {
+			return data, nil
+		}
+
+		var rErr *ResponseError
+		if retry >= maxRetries || !errors.As(err, &rErr) || rErr.StatusCode != http.StatusTooManyRequests {
+			limiter.fail()
+			return nil, err
+		}
+
+		limiter.pause(toRetryAfter(rErr.Header, retryAfter))
+	}
+}
+
+// toRetryAfter returns the Retry-After header duration in seconds, or fallback when absent
+func toRetryAfter(header http.Header, fallback time.Duration) time.Duration {
+	if seconds, err := strconv.Atoi(header.Get("Retry-After")); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	return fallback
+}
+
+// rateLimiter spaces out concurrent requests by interval, and pauses all of them once one of them is rate limited
+type rateLimiter struct {
+	mu          sync.Mutex
+	interval    time.Duration // Minimum time between requests, 0 for no spacing
+	next        time.Time     // Earliest time of the next request
+	pausedUntil time.Time
+	hasFailed   bool
+}
+
+func (l *rateLimiter) pause(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until := time.Now().Add(d); until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// wait blocks until the request may be made. Pauses recorded while waiting are honoured too.
+func (l *rateLimiter) wait() {
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		until := l.next
+		if l.pausedUntil.After(until) {
+			until = l.pausedUntil
+		}
+		if !now.Before(until) {
+			l.next = now.Add(l.interval)
+			l.mu.Unlock()
+			return
+		}
+		l.mu.Unlock()
+
+		time.Sleep(until.Sub(now))
+	}
+}
+
+func (l *rateLimiter) fail() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.hasFailed = true
+}
+
+func (l *rateLimiter) failed() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.hasFailed
+}
+
 type CoinsIDMarketChartParams struct {
-	CoinsID    string `json:"coins_id"`    // CoinID (can be obtained from /coins)
-	VsCurrency string `json:"vs_currency"` // The target currency of market data (usd, eur, jpy, etc.)
-	Days       string `json:"days"`        // Data up to number of days ago (eg. 1,14,30,max)
+	CoinsID    string                       `json:"coins_id"`    // CoinID (can be obtained from /coins)
+	VsCurrency string                       `json:"vs_currency"` // The target currency of market data (usd, eur, jpy, etc.)
+	Days       string                       `json:"days"`        // Data up to number of days ago (eg. 1,14,30,max)
+	Interval   types.MarketChartGranularity `json:"interval"`    // When auto, granularity is determined by Days. Paid plan only: 5-minute for 1 day, hourly up to 90 days.
+	Precision  string                       `json:"precision"`   // Decimal place for currency price value. Either "full" or int [0,18]. When empty, default precision.
 }
 
 func (p CoinsIDMarketChartParams) Validate() error {
@@ -297,11 +786,26 @@ func (p CoinsIDMarketChartParams) Validate() error {
 		return fmt.Errorf("VsCurrency is required")
 	}
 
-	if p.Days == "" {
-		return fmt.Errorf("Days is required")
+	if err := validateDays(p.Days); err != nil {
+		return err
 	}
 
-	return nil
+	if !p.Interval.Valid() {
+		return fmt.Errorf("invalid Interval %d", p.Interval)
+	}
+
+	switch p.Interval {
+	case types.MarketChartGranularityFiveMinutes:
+		if p.Days != "1" {
+			return fmt.Errorf("5-minute Interval is only available for 1 day, got Days %q", p.Days)
+		}
+	case types.MarketChartGranularityHourly:
+		if p.Days == "max" || toInt(p.Days) > 90 {
+			return fmt.Errorf("hourly Interval is only available up to 90 days, got Days %q", p.Days)
+		}
+	}
+
+	return validatePrecision(p.Precision)
 }
 
 func (p CoinsIDMarketChartParams) encodeNonIDQueryParams() string {
@@ -309,18 +813,192 @@ func (p CoinsIDMarketChartParams) encodeNonIDQueryParams() string {
 
 	params.Add("vs_currency", p.VsCurrency)
 	params.Add("days", p.Days)
+	if p.Interval != types.MarketChartGranularityAuto {
+		params.Add("interval", p.Interval.String())
+	}
+	if p.Precision != "" {
+		params.Add("precision", p.Precision)
+	}
 
 	return params.Encode()
 }
 
+// granularity returns the requested Interval, or the one CoinGecko picks from Days: 5-minute for 1 day, hourly up to
+// 90 days, daily beyond.
+func (p CoinsIDMarketChartParams) granularity() types.MarketChartGranularity {
+	if p.Interval != types.MarketChartGranularityAuto {
+		return p.Interval
+	}
+
+	return toMarketChartGranularity(p.Days == "1", p.Days != "max" && toInt(p.Days) <= 90)
+}
+
+func toMarketChartGranularity(withinDay, within90Days bool) types.MarketChartGranularity {
+	switch {
+	case withinDay:
+		return types.MarketChartGranularityFiveMinutes
+	case within90Days:
+		return types.MarketChartGranularityHourly
+	default:
+		return types.MarketChartGranularityDaily
+	}
+}
+
+// validateDays validates days as either a positive integer or "max"
+func validateDays(days string) error {
+	if days == "max" {
+		return nil
+	}
+
+	if d, err := strconv.Atoi(days); err != nil || d < 1 {
+		return fmt.Errorf("Days must either be a positive integer or \"max\", got %q", days)
+	}
+
+	return nil
+}
+
 // CoinsIDMarketChart /coins/{id}/market_chart?vs_currency={usd, eur, jpy, etc.}&days={1,14,30,max}
 func (c *Client) CoinsIDMarketChart(params CoinsIDMarketChartParams) (*types.CoinsIDMarketChart, error) {
 	if err := params.Validate(); err != nil {
 		return nil, err
 	}
 
+	paidInterval := params.Interval == types.MarketChartGranularityFiveMinutes || params.Interval == types.MarketChartGranularityHourly
+	if paidInterval {
+		if err := c.requirePro("/coins/{id}/market_chart with interval"); err != nil {
+			return nil, err
+		}
+	}
+
 	coinsIDMarketChartURL := fmt.Sprintf("%s/coins/%s/market_chart?%s", c.baseURL, params.CoinsID, params.encodeNonIDQueryParams())
 	resp, header, err := c.makeHTTPRequest(coinsIDMarketChartURL)
+	if err != nil {
+		if paidInterval {
+			return nil, toPlanRestrictedError(err, "/coins/{id}/market_chart with interval")
+		}
+
+		return nil, err
+	}
+
+	data := &types.CoinsIDMarketChart{
+		BaseResult:  types.NewBaseResult(header),
+		Granularity: params.granularity(),
+	}
+	if err = json.Unmarshal(resp, &data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+type CoinsIDMarketChartRangeParams struct {
+	CoinsID     string                       `json:"coins_id"`    // CoinID (can be obtained from /coins)
+	VsCurrency  string                       `json:"vs_currency"` // The target currency of market data (usd, eur, jpy, etc.)
+	From        time.Time                    `json:"from"`        // Start of the range, inclusive
+	To          time.Time                    `json:"to"`          // End of the range, inclusive
+	Granularity types.MarketChartGranularity `json:"granularity"` // When not auto, the range is split into chunks that yields at least the granularity, then resampled to it
+}
+
+func (p CoinsIDMarketChartRangeParams) Validate() error {
+	if p.CoinsID == "" {
+		return fmt.Errorf("CoinsID is required")
+	}
+
+	if p.VsCurrency == "" {
+		return fmt.Errorf("VsCurrency is required")
+	}
+
+	if p.From.IsZero() || p.To.IsZero() {
+		return fmt.Errorf("From and To are required")
+	}
+
+	if !p.From.Before(p.To) {
+		return fmt.Errorf("From must be before To")
+	}
+
+	if !p.Granularity.Valid() {
+		return fmt.Errorf("invalid Granularity %d", p.Granularity)
+	}
+
+	return nil
+}
+
+// maxChunkSize returns the longest range that CoinGecko serves with the requested granularity, 0 when the range
+// should not be split.
+func (p CoinsIDMarketChartRangeParams) maxChunkSize() time.Duration {
+	switch p.Granularity {
+	case types.MarketChartGranularityFiveMinutes:
+		return 24 * time.Hour
+	case types.MarketChartGranularityHourly:
+		return 90 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+func (p CoinsIDMarketChartRangeParams) encodeNonIDQueryParams(from, to time.Time) string {
+	params := url.Values{}
+
+	params.Add("vs_currency", p.VsCurrency)
+	params.Add("from", strconv.FormatInt(from.Unix(), 10))
+	params.Add("to", strconv.FormatInt(to.Unix(), 10))
+
+	return params.Encode()
+}
+
+// CoinsIDMarketChartRange /coins/{id}/market_chart/range?vs_currency={usd, eur, jpy, etc.}&from={unix}&to={unix}
+//
+// CoinGecko picks the granularity from the range length: 5-minute within 1 day, hourly within 90 days, daily beyond.
+// When params.Granularity is not auto, long ranges are fetched in equally sized chunks, so no chunk is short enough
+// to be served at a finer granularity, and the stitched data points are resampled to params.Granularity.
+func (c *Client) CoinsIDMarketChartRange(params CoinsIDMarketChartRangeParams) (*types.CoinsIDMarketChart, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	length := params.To.Sub(params.From)
+	data := &types.CoinsIDMarketChart{
+		Granularity: params.Granularity,
+	}
+	if params.Granularity == types.MarketChartGranularityAuto {
+		data.Granularity = toMarketChartGranularity(length <= 24*time.Hour, length <= 90*24*time.Hour)
+	}
+
+	chunks := 1
+	if maxChunkSize := params.maxChunkSize(); maxChunkSize > 0 {
+		chunks = int((length + maxChunkSize - 1) / maxChunkSize)
+	}
+
+	chunkSize := length / time.Duration(chunks)
+	for i := 0; i < chunks; i++ {
+		from := params.From.Add(time.Duration(i) * chunkSize)
+		to := from.Add(chunkSize)
+		if i == chunks-1 {
+			to = params.To
+		}
+
+		chunk, err := c.coinsIDMarketChartRange(params, from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		data.BaseResult = chunk.BaseResult
+		data.Prices = append(data.Prices, chunk.Prices...)
+		data.MarketCaps = append(data.MarketCaps, chunk.MarketCaps...)
+		data.TotalVolumes = append(data.TotalVolumes, chunk.TotalVolumes...)
+	}
+
+	interval := params.Granularity.Duration()
+	data.Prices = types.ResampleChartItems(types.SortUniqueChartItems(data.Prices), interval)
+	data.MarketCaps = types.ResampleChartItems(types.SortUniqueChartItems(data.MarketCaps), interval)
+	data.TotalVolumes = types.ResampleChartItems(types.SortUniqueChartItems(data.TotalVolumes), interval)
+
+	return data, nil
+}
+
+func (c *Client) coinsIDMarketChartRange(params CoinsIDMarketChartRangeParams, from, to time.Time) (*types.CoinsIDMarketChart, error) {
+	rangeURL := fmt.Sprintf("%s/coins/%s/market_chart/range?%s", c.baseURL, params.CoinsID, params.encodeNonIDQueryParams(from, to))
+	resp, header, err := c.makeHTTPRequest(rangeURL)
 	if err != nil {
 		return nil, err
 	}
@@ -335,13 +1013,155 @@ func (c *Client) CoinsIDMarketChart(params CoinsIDMarketChartParams) (*types.Coi
 	return data, nil
 }
 
-// CoinsIDStatusUpdates
+var coinsIDOHLCDays = []string{"1", "7", "14", "30", "90", "180", "365", "max"}
+
+type CoinsIDOHLCParams struct {
+	CoinsID    string             `json:"coins_id"`    // CoinID (can be obtained from /coins)
+	VsCurrency string             `json:"vs_currency"` // The target currency of market data (usd, eur, jpy, etc.)
+	Days       string             `json:"days"`        // Data up to number of days ago. Valid values: 1,7,14,30,90,180,365,max
+	Interval   types.OHLCInterval `json:"interval"`    // Paid plan only. When auto, granularity is determined by Days
+}
+
+func (p CoinsIDOHLCParams) Validate() error {
+	if p.CoinsID == "" {
+		return fmt.Errorf("CoinsID is required")
+	}
+
+	if p.VsCurrency == "" {
+		return fmt.Errorf("VsCurrency is required")
+	}
+
+	validDays := false
+	for _, days := range coinsIDOHLCDays {
+		if p.Days == days {
+			validDays = true
+			break
+		}
+	}
+	if !validDays {
+		return fmt.Errorf("Days must be one of %s", strings.Join(coinsIDOHLCDays, ","))
+	}
+
+	if p.Interval < types.OHLCIntervalAuto || p.Interval > types.OHLCIntervalHourly {
+		return fmt.Errorf("invalid Interval %d", p.Interval)
+	}
+
+	return nil
+}
+
+func (p CoinsIDOHLCParams) encodeNonIDQueryParams() string {
+	params := url.Values{}
 
-// CoinsIDContractAddress https://api.coingecko.com/api/v3/coins/{id}/contract/{contract_address}
-// func CoinsIDContractAddress(id string, address string) (nil, error) {
-// 	url := fmt.Sprintf("%s/coins/%s/contract/%s", c.baseURL, id, address)
-// 	resp, err := request.makeHTTPRequest(url)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-// }
+	params.Add("vs_currency", p.VsCurrency)
+	params.Add("days", p.Days)
+	if p.Interval != types.OHLCIntervalAuto {
+		params.Add("interval", p.Interval.String())
+	}
+
+	return params.Encode()
+}
+
+// CoinsIDOHLC /coins/{id}/ohlc?vs_currency={usd, eur, jpy, etc.}&days={1,7,14,30,90,180,365,max}
+func (c *Client) CoinsIDOHLC(params CoinsIDOHLCParams) (*types.CoinsIDOHLC, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	paidInterval := params.Interval != types.OHLCIntervalAuto
+	if paidInterval {
+		if err := c.requirePro("/coins/{id}/ohlc with interval"); err != nil {
+			return nil, err
+		}
+	}
+
+	coinsIDOHLCURL := fmt.Sprintf("%s/coins/%s/ohlc?%s", c.baseURL, params.CoinsID, params.encodeNonIDQueryParams())
+	resp, header, err := c.makeHTTPRequest(coinsIDOHLCURL)
+	if err != nil {
+		if paidInterval {
+			return nil, toPlanRestrictedError(err, "/coins/{id}/ohlc with interval")
+		}
+
+		return nil, err
+	}
+
+	data := &types.CoinsIDOHLC{
+		BaseResult: types.NewBaseResult(header),
+		Candles:    []types.OHLCItem{},
+	}
+	if err = json.Unmarshal(resp, &data.Candles); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+var (
+	ErrAssetPlatformNotFound = errors.New("asset platform not found")
+	ErrContractNotFound      = errors.New("contract not found")
+)
+
+type CoinsIDContractAddressParams struct {
+	AssetPlatformID string `json:"asset_platform_id"` // Asset platform ID (e.g. ethereum, solana), refers to AssetPlatforms
+	ContractAddress string `json:"contract_address"`  // Token contract address
+}
+
+func (p CoinsIDContractAddressParams) Validate() error {
+	if p.AssetPlatformID == "" {
+		return fmt.Errorf("AssetPlatformID is required")
+	}
+
+	if p.ContractAddress == "" {
+		return fmt.Errorf("ContractAddress is required")
+	}
+
+	return nil
+}
+
+// CoinsIDContractAddress /coins/{id}/contract/{contract_address}. Returns an error wrapping ErrAssetPlatformNotFound or
+// ErrContractNotFound when CoinGecko does not recognize the asset platform or the contract respectively. On 404 an
+// extra AssetPlatforms request is made to tell the two apart.
+func (c *Client) CoinsIDContractAddress(params CoinsIDContractAddressParams) (*types.CoinsIDContractAddress, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	contractURL := fmt.Sprintf("%s/coins/%s/contract/%s", c.baseURL, url.PathEscape(params.AssetPlatformID), url.PathEscape(params.ContractAddress))
+	resp, header, err := c.makeHTTPRequest(contractURL)
+	if err != nil {
+		return nil, c.toContractNotFoundError(err, params.AssetPlatformID, params.ContractAddress)
+	}
+
+	data := &types.CoinsIDContractAddress{
+		CoinsID: types.CoinsID{
+			BaseResult: types.NewBaseResult(header),
+		},
+	}
+	if err = json.Unmarshal(resp, &data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// toContractNotFoundError maps CoinGecko's 404 response of contract endpoints to ErrAssetPlatformNotFound or
+// ErrContractNotFound. CoinGecko answers both cases with the same body, so the asset platform is looked up in
+// AssetPlatforms. Other errors, or a failing lookup, are returned as is.
+func (c *Client) toContractNotFoundError(err error, assetPlatformID, contractAddress string) error {
+	var rErr *ResponseError
+	if !errors.As(err, &rErr) || rErr.StatusCode != http.StatusNotFound {
+		return err
+	}
+
+	platforms, pErr := c.AssetPlatforms(types.AssetPlatformsFilterNone)
+	if pErr != nil {
+		return err
+	}
+
+	for _, platform := range platforms.Platforms {
+		if platform.ID == assetPlatformID {
+			return fmt.Errorf("%w: %s on %s", ErrContractNotFound, contractAddress, assetPlatformID)
+		}
+	}
+
+	return fmt.Errorf("%w: %s", ErrAssetPlatformNotFound, assetPlatformID)
+}
@@ -0,0 +1,88 @@
+package coingecko
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/buger/jsonparser"
+	"github.com/edward-yakop/go-gecko/v3/types"
+	"net/url"
+)
+
+// CoinsCategoriesList /coins/categories/list
+func (c *Client) CoinsCategoriesList() (*types.CoinsCategoriesList, error) {
+	coinsCategoriesListURL := fmt.Sprintf("%s/coins/categories/list", c.baseURL)
+
+	resp, header, err := c.makeHTTPRequest(coinsCategoriesListURL)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]string)
+	r := &types.CoinsCategoriesList{
+		BaseResult: types.NewBaseResult(header),
+		Categories: m,
+	}
+
+	itemPaths := [][]string{
+		{"category_id"},
+		{"name"},
+	}
+	_, _ = jsonparser.ArrayEach(resp, func(ba []byte, _ jsonparser.ValueType, _ int, pErr error) {
+		hasError := err != nil || pErr != nil
+		if hasError {
+			err = firstError(err, pErr)
+			return
+		}
+
+		var id, name string
+		jsonparser.EachKey(ba, func(idx int, ba []byte, _ jsonparser.ValueType, pErr error) {
+			hasError = err != nil || pErr != nil
+			if hasError {
+				err = firstError(err, pErr)
+				return
+			}
+
+			switch idx {
+			case 0: // category_id
+				id = string(ba)
+			case 1: // name
+				name = string(ba)
+			}
+		}, itemPaths...)
+		if err == nil {
+			m[id] = name
+		}
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// CoinsCategories /coins/categories?order={order}
+func (c *Client) CoinsCategories(order types.CoinsCategoriesOrder) (*types.CoinsCategories, error) {
+	if !order.Valid() {
+		return nil, fmt.Errorf("invalid order %d", order)
+	}
+
+	params := url.Values{}
+	params.Add("order", order.String())
+
+	coinsCategoriesURL := fmt.Sprintf("%s/coins/categories?%s", c.baseURL, params.Encode())
+	resp, header, err := c.makeHTTPRequest(coinsCategoriesURL)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &types.CoinsCategories{
+		BaseResult: types.NewBaseResult(header),
+		Categories: []types.CoinsCategoryItem{},
+	}
+	if err = json.Unmarshal(resp, &data.Categories); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
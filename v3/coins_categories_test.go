@@ -0,0 +1,55 @@
+package coingecko
+
+import (
+	"github.com/edward-yakop/go-gecko/v3/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestClient_CoinsCategoriesList(t *testing.T) {
+	err := setupGock("json/coins_categories_list.json", "json/common.headers.json", "/coins/categories/list")
+	require.NoError(t, err)
+
+	got, err := c.CoinsCategoriesList()
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+
+	assert.Len(t, got.Categories, 4)
+	assert.Equal(t, "Decentralized Finance (DeFi)", got.Categories["decentralized-finance-defi"])
+}
+
+func TestClient_CoinsCategories(t *testing.T) {
+	err := setupGock("json/coins_categories.json", "json/common.headers.json", "/coins/categories")
+	require.NoError(t, err)
+
+	got, err := c.CoinsCategories(types.CoinsCategoriesOrderMarketCapChange24hDesc)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+
+	require.Len(t, got.Categories, 2)
+
+	scp := got.Categories[0]
+	assert.Equal(t, "smart-contract-platform", scp.ID, "scp.ID")
+	assert.Equal(t, "Smart Contract Platform", scp.Name, "scp.Name")
+	assert.Equal(t, 487163016413.9286, *scp.MarketCap, "scp.MarketCap")
+	assert.Equal(t, 1.3720698770478306, *scp.MarketCapChange24h, "scp.MarketCapChange24h")
+	assert.Len(t, scp.Top3Coins, 3, "scp.Top3Coins")
+	assert.Equal(t, 15196234137.484375, *scp.Volume24h, "scp.Volume24h")
+	assert.Equal(t, time.Date(2023, time.January, 11, 12, 35, 6, 514000000, time.UTC), scp.UpdatedAt.Time, "scp.UpdatedAt")
+
+	empty := got.Categories[1]
+	assert.Nil(t, empty.MarketCap, "empty.MarketCap")
+	assert.Nil(t, empty.MarketCapChange24h, "empty.MarketCapChange24h")
+	assert.Nil(t, empty.Volume24h, "empty.Volume24h")
+}
+
+func TestClient_CoinsCategories_invalidOrder(t *testing.T) {
+	_, err := c.CoinsCategories(types.CoinsCategoriesOrder(100))
+	assert.Error(t, err)
+}
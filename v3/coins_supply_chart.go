@@ -0,0 +1,150 @@
+package coingecko
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/edward-yakop/go-gecko/v3/types"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type CoinsIDSupplyChartParams struct {
+	CoinsID string `json:"coins_id"` // CoinID (can be obtained from /coins)
+	Days    string `json:"days"`     // Data up to number of days ago (eg. 1,14,30,max)
+	Daily   bool   `json:"daily"`    // Sets to true to request daily interval regardless of Days
+}
+
+func (p CoinsIDSupplyChartParams) Validate() error {
+	if p.CoinsID == "" {
+		return fmt.Errorf("CoinsID is required")
+	}
+
+	return validateDays(p.Days)
+}
+
+func (p CoinsIDSupplyChartParams) encodeNonIDQueryParams() string {
+	params := url.Values{}
+
+	params.Add("days", p.Days)
+	if p.Daily {
+		params.Add("interval", "daily")
+	}
+
+	return params.Encode()
+}
+
+type CoinsIDSupplyChartRangeParams struct {
+	CoinsID string    `json:"coins_id"` // CoinID (can be obtained from /coins)
+	From    time.Time `json:"from"`     // Start of the range. Required.
+	To      time.Time `json:"to"`       // End of the range. Required.
+}
+
+func (p CoinsIDSupplyChartRangeParams) Validate() error {
+	if p.CoinsID == "" {
+		return fmt.Errorf("CoinsID is required")
+	}
+
+	if p.From.IsZero() || p.To.IsZero() {
+		return fmt.Errorf("From and To are required")
+	}
+
+	if !p.From.Before(p.To) {
+		return fmt.Errorf("From must be before To")
+	}
+
+	return nil
+}
+
+func (p CoinsIDSupplyChartRangeParams) encodeNonIDQueryParams() string {
+	params := url.Values{}
+
+	params.Add("from", strconv.FormatInt(p.From.Unix(), 10))
+	params.Add("to", strconv.FormatInt(p.To.Unix(), 10))
+
+	return params.Encode()
+}
+
+// CoinsIDCirculatingSupplyChart /coins/{id}/circulating_supply_chart?days={1,14,30,max}. Enterprise plan only.
+func (c *Client) CoinsIDCirculatingSupplyChart(params CoinsIDSupplyChartParams) (*types.CoinsIDCirculatingSupplyChart, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	chartURL := fmt.Sprintf("%s/coins/%s/circulating_supply_chart?%s", c.baseURL, params.CoinsID, params.encodeNonIDQueryParams())
+
+	return c.coinsIDCirculatingSupplyChart("/coins/{id}/circulating_supply_chart", chartURL)
+}
+
+// CoinsIDCirculatingSupplyChartRange /coins/{id}/circulating_supply_chart/range?from={unix}&to={unix}. Enterprise plan only.
+func (c *Client) CoinsIDCirculatingSupplyChartRange(params CoinsIDSupplyChartRangeParams) (*types.CoinsIDCirculatingSupplyChart, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	chartURL := fmt.Sprintf("%s/coins/%s/circulating_supply_chart/range?%s", c.baseURL, params.CoinsID, params.encodeNonIDQueryParams())
+
+	return c.coinsIDCirculatingSupplyChart("/coins/{id}/circulating_supply_chart/range", chartURL)
+}
+
+func (c *Client) coinsIDCirculatingSupplyChart(endpoint, chartURL string) (*types.CoinsIDCirculatingSupplyChart, error) {
+	if err := c.requirePro(endpoint); err != nil {
+		return nil, err
+	}
+
+	resp, header, err := c.makeHTTPRequest(chartURL)
+	if err != nil {
+		return nil, toPlanRestrictedError(err, endpoint)
+	}
+
+	data := &types.CoinsIDCirculatingSupplyChart{
+		BaseResult: types.NewBaseResult(header),
+	}
+	if err = json.Unmarshal(resp, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// CoinsIDTotalSupplyChart /coins/{id}/total_supply_chart?days={1,14,30,max}. Enterprise plan only.
+func (c *Client) CoinsIDTotalSupplyChart(params CoinsIDSupplyChartParams) (*types.CoinsIDTotalSupplyChart, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	chartURL := fmt.Sprintf("%s/coins/%s/total_supply_chart?%s", c.baseURL, params.CoinsID, params.encodeNonIDQueryParams())
+
+	return c.coinsIDTotalSupplyChart("/coins/{id}/total_supply_chart", chartURL)
+}
+
+// CoinsIDTotalSupplyChartRange /coins/{id}/total_supply_chart/range?from={unix}&to={unix}. Enterprise plan only.
+func (c *Client) CoinsIDTotalSupplyChartRange(params CoinsIDSupplyChartRangeParams) (*types.CoinsIDTotalSupplyChart, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	chartURL := fmt.Sprintf("%s/coins/%s/total_supply_chart/range?%s", c.baseURL, params.CoinsID, params.encodeNonIDQueryParams())
+
+	return c.coinsIDTotalSupplyChart("/coins/{id}/total_supply_chart/range", chartURL)
+}
+
+func (c *Client) coinsIDTotalSupplyChart(endpoint, chartURL string) (*types.CoinsIDTotalSupplyChart, error) {
+	if err := c.requirePro(endpoint); err != nil {
+		return nil, err
+	}
+
+	resp, header, err := c.makeHTTPRequest(chartURL)
+	if err != nil {
+		return nil, toPlanRestrictedError(err, endpoint)
+	}
+
+	data := &types.CoinsIDTotalSupplyChart{
+		BaseResult: types.NewBaseResult(header),
+	}
+	if err = json.Unmarshal(resp, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
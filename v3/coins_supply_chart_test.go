@@ -0,0 +1,94 @@
+package coingecko
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestClient_CoinsIDCirculatingSupplyChart(t *testing.T) {
+	err := setupProGock("json/coins_id_circulating_supply_chart.json", "json/common.headers.json", "/coins/bitcoin/circulating_supply_chart")
+	require.NoError(t, err)
+
+	got, err := proC.CoinsIDCirculatingSupplyChart(CoinsIDSupplyChartParams{
+		CoinsID: "bitcoin",
+		Days:    "3",
+		Daily:   true,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+
+	require.Len(t, got.CirculatingSupply, 3)
+	assert.Equal(t, time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC), got.CirculatingSupply[0].Time.UTC(), "got.CirculatingSupply[0].Time")
+	assert.Equal(t, 19252031.0, got.CirculatingSupply[0].Value, "got.CirculatingSupply[0].Value")
+}
+
+func TestClient_CoinsIDCirculatingSupplyChartRange(t *testing.T) {
+	err := setupProGock("json/coins_id_circulating_supply_chart.json", "json/common.headers.json", "/coins/bitcoin/circulating_supply_chart/range")
+	require.NoError(t, err)
+
+	from := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	got, err := proC.CoinsIDCirculatingSupplyChartRange(CoinsIDSupplyChartRangeParams{
+		CoinsID: "bitcoin",
+		From:    from,
+		To:      from.AddDate(0, 0, 2),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Len(t, got.CirculatingSupply, 3)
+}
+
+func TestClient_CoinsIDTotalSupplyChart(t *testing.T) {
+	err := setupProGock("json/coins_id_total_supply_chart.json", "json/common.headers.json", "/coins/bitcoin/total_supply_chart")
+	require.NoError(t, err)
+
+	got, err := proC.CoinsIDTotalSupplyChart(CoinsIDSupplyChartParams{
+		CoinsID: "bitcoin",
+		Days:    "max",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+
+	require.Len(t, got.TotalSupply, 2)
+	assert.Equal(t, 21000000.0, got.TotalSupply[1].Value, "got.TotalSupply[1].Value")
+}
+
+func TestClient_CoinsIDTotalSupplyChartRange(t *testing.T) {
+	err := setupProGock("json/coins_id_total_supply_chart.json", "json/common.headers.json", "/coins/bitcoin/total_supply_chart/range")
+	require.NoError(t, err)
+
+	from := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	got, err := proC.CoinsIDTotalSupplyChartRange(CoinsIDSupplyChartRangeParams{
+		CoinsID: "bitcoin",
+		From:    from,
+		To:      from.AddDate(0, 0, 1),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Len(t, got.TotalSupply, 2)
+}
+
+func TestClient_CoinsIDSupplyChart_notPro(t *testing.T) {
+	_, err := c.CoinsIDTotalSupplyChart(CoinsIDSupplyChartParams{CoinsID: "bitcoin", Days: "1"})
+	assert.ErrorIs(t, err, ErrProPlanRequired)
+
+	_, err = c.CoinsIDCirculatingSupplyChart(CoinsIDSupplyChartParams{CoinsID: "bitcoin", Days: "1"})
+	assert.ErrorIs(t, err, ErrProPlanRequired)
+}
+
+func TestCoinsIDSupplyChartParams_Validate(t *testing.T) {
+	for _, days := range []string{"1", "max"} {
+		assert.NoError(t, CoinsIDSupplyChartParams{CoinsID: "bitcoin", Days: days}.Validate(), "Days %q", days)
+	}
+
+	for _, days := range []string{"", "0", "-1", "week"} {
+		assert.Error(t, CoinsIDSupplyChartParams{CoinsID: "bitcoin", Days: days}.Validate(), "Days %q", days)
+	}
+}
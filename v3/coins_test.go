@@ -1,9 +1,13 @@
 package coingecko
 
 import (
+	"fmt"
 	"github.com/edward-yakop/go-gecko/v3/types"
+	"github.com/h2non/gock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"net/http"
+	"strconv"
 	"testing"
 	"time"
 )
@@ -12,13 +16,48 @@ func TestCoinsList(t *testing.T) {
 	err := setupGock("json/coins_list.json", "json/common.headers.json", "/coins/list")
 	require.NoError(t, err)
 
-	list, err := c.CoinsList()
+	list, err := c.CoinsList(CoinsListParams{})
 	require.NoError(t, err)
 	require.NotNil(t, list)
 
 	item := list.Coins[0]
 	assert.Equal(t, commonBaseResult, list.BaseResult)
 	assert.Equal(t, "01coin", item.ID, "item.ID")
+	assert.Nil(t, item.Platforms, "item.Platforms")
+}
+
+func TestClient_CoinsList_includePlatformInactive(t *testing.T) {
+	gock.New(mockURL).
+		Get("/coins/list").
+		MatchParams(map[string]string{
+			"include_platform": "^true$",
+			"status":           "^inactive$",
+		}).
+		Reply(http.StatusOK).
+		File("json/coins_list_include_platform.json")
+
+	list, err := c.CoinsList(CoinsListParams{
+		IncludePlatform: true,
+		Status:          types.CoinsListStatusInactive,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, list)
+	assert.True(t, gock.IsDone(), "gock.IsDone")
+
+	require.Len(t, list.Coins, 2)
+	assert.Equal(t, map[string]string{
+		"ethereum":  "0xe41d2489571d322189246dafa5ebde1f4699f498",
+		"energi":    "0x591c19dc0821704bedaa5bbc6a66fee277d9437e",
+		"avalanche": "0x596fa47043f99a4e0f122243b841e55375cde0d2",
+	}, list.Coins[0].Platforms, "list.Coins[0].Platforms")
+	assert.Empty(t, list.Coins[1].Platforms, "list.Coins[1].Platforms")
+}
+
+func TestCoinsListParams_encodeQueryParams(t *testing.T) {
+	assert.Equal(t, "", CoinsListParams{}.encodeQueryParams())
+	assert.Equal(t, "include_platform=true", CoinsListParams{IncludePlatform: true}.encodeQueryParams())
+	assert.Equal(t, "status=inactive", CoinsListParams{Status: types.CoinsListStatusInactive}.encodeQueryParams())
+	assert.Error(t, CoinsListParams{Status: 2}.Valid())
 }
 
 func TestClient_CoinsMarket(t *testing.T) {
@@ -49,9 +88,13 @@ func TestClient_CoinsMarket(t *testing.T) {
 		assert.Equal(t, "btc", btc.Symbol)
 		assert.Equal(t, "Bitcoin EY", btc.Name)
 		assert.Equal(t, 16919.92, btc.CurrentPrice)
+		assert.Equal(t, "16919.92", btc.CurrentPriceDecimal.String(), "btc.CurrentPriceDecimal")
+		assert.Equal(t, "325774667477", btc.MarketCapDecimal.String(), "btc.MarketCapDecimal")
+		assert.Equal(t, "16971721891", btc.TotalVolumeDecimal.String(), "btc.TotalVolumeDecimal")
 		assert.Equal(t, 325774667477, int(btc.MarketCap))
 		assert.Equal(t, 1, btc.MarketCapRank)
-		assert.Equal(t, time.Date(2023, time.January, 7, 11, 29, 25, 554000000, time.UTC), btc.LastUpdated)
+		assert.Equal(t, time.Date(2023, time.January, 7, 11, 29, 25, 554000000, time.UTC), btc.LastUpdated.Time)
+		assert.Equal(t, time.Date(2021, time.November, 10, 14, 24, 11, 849000000, time.UTC), btc.ATHDate.Time, "btc.ATHDate")
 	}
 
 	if eth := market.Markets[1]; assert.Equal(t, "ethereum", eth.ID, "market[1].ID") {
@@ -60,7 +103,7 @@ func TestClient_CoinsMarket(t *testing.T) {
 		assert.Equal(t, 1263.96, eth.CurrentPrice)
 		assert.Equal(t, 152320057898, int(eth.MarketCap))
 		assert.Equal(t, 2, eth.MarketCapRank)
-		assert.Equal(t, time.Date(2023, time.January, 7, 11, 29, 50, 236000000, time.UTC), eth.LastUpdated)
+		assert.Equal(t, time.Date(2023, time.January, 7, 11, 29, 50, 236000000, time.UTC), eth.LastUpdated.Time)
 	}
 
 	if steem := market.Markets[2]; assert.Equal(t, "steem", steem.ID, "market[2].ID") {
@@ -69,10 +112,76 @@ func TestClient_CoinsMarket(t *testing.T) {
 		assert.Equal(t, 0.150837, steem.CurrentPrice)
 		assert.Equal(t, 63951772, int(steem.MarketCap))
 		assert.Equal(t, 298, steem.MarketCapRank)
-		assert.Equal(t, time.Date(2023, time.January, 7, 11, 29, 50, 765000000, time.UTC), steem.LastUpdated)
+		assert.Equal(t, time.Date(2023, time.January, 7, 11, 29, 50, 765000000, time.UTC), steem.LastUpdated.Time)
 	}
 }
 
+func TestCoinsMarketParams_encodeQueryParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		params CoinsMarketParams
+		want   string
+	}{
+		{
+			"defaults",
+			CoinsMarketParams{VsCurrency: "usd"},
+			"order=market_cap_desc&page=1&per_page=100&vs_currency=usd",
+		},
+		{
+			"all",
+			CoinsMarketParams{
+				VsCurrency: "eur",
+				CoinIDs:    []string{"bitcoin", "ethereum"},
+				Names:      []string{"Bitcoin"},
+				Symbols:    []string{"btc", "eth"},
+				Category:   "layer-1",
+				Order:      types.CoinMarketOrderIDAsc,
+				PageSize:   50,
+				PageNo:     3,
+				Sparkline:  true,
+				PriceChangePercentage: []types.PriceChangePercentage{
+					types.PriceChangePercentage1H,
+					types.PriceChangePercentage60D,
+				},
+				Locale:    "de",
+				Precision: "full",
+			},
+			"category=layer-1&ids=bitcoin%2Cethereum&locale=de&names=Bitcoin&order=id_asc&page=3&per_page=50&" +
+				"precision=full&price_change_percentage=1h%2C60d&sparkline=true&symbols=btc%2Ceth&vs_currency=eur",
+		},
+		{
+			"all tokens of symbols",
+			CoinsMarketParams{VsCurrency: "usd", Symbols: []string{"usdt"}, IncludeAllTokens: true, PageSize: 250, PageNo: 2},
+			"include_tokens=all&order=market_cap_desc&page=2&per_page=250&symbols=usdt&vs_currency=usd",
+		},
+		{
+			"include tokens without symbols is ignored",
+			CoinsMarketParams{VsCurrency: "usd", IncludeAllTokens: true, Precision: "2"},
+			"order=market_cap_desc&page=1&per_page=100&precision=2&vs_currency=usd",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.params.encodeQueryParams())
+		})
+	}
+}
+
+func TestCoinsMarketParams_Validate(t *testing.T) {
+	assert.NoError(t, CoinsMarketParams{VsCurrency: "usd", Order: types.CoinMarketOrderIDDesc, Locale: "zh-tw", Precision: "18"}.Validate())
+	assert.NoError(t, CoinsMarketParams{VsCurrency: "usd", PageSize: 250, PageNo: 3}.Validate(), "PageSize and PageNo")
+
+	assert.Error(t, CoinsMarketParams{}.Validate(), "missing VsCurrency")
+	assert.Error(t, CoinsMarketParams{VsCurrency: "usd", PageSize: 300}.Validate(), "PageSize above 250")
+	assert.Error(t, CoinsMarketParams{VsCurrency: "usd", PageSize: -1}.Validate(), "negative PageSize")
+	assert.Error(t, CoinsMarketParams{VsCurrency: "usd", PageNo: -1}.Validate(), "negative PageNo")
+	assert.Error(t, CoinsMarketParams{VsCurrency: "usd", Order: 8}.Validate(), "Order")
+	assert.Error(t, CoinsMarketParams{VsCurrency: "usd", Order: -1}.Validate(), "negative Order")
+	assert.Error(t, CoinsMarketParams{VsCurrency: "usd", PriceChangePercentage: []types.PriceChangePercentage{8}}.Validate(), "PriceChangePercentage")
+	assert.Error(t, CoinsMarketParams{VsCurrency: "usd", Locale: "xx"}.Validate(), "Locale")
+	assert.Error(t, CoinsMarketParams{VsCurrency: "usd", Precision: "19"}.Validate(), "Precision")
+}
+
 func TestCoinsID(t *testing.T) {
 	err := setupGock("json/coins_id.json", "json/common.headers.json", "/coins/dogecoin")
 	require.NoError(t, err)
@@ -100,11 +209,102 @@ func TestCoinsID(t *testing.T) {
 	assert.Equal(t, "الدوجكوين", coin.Localization["ar"], "coin.Localization[\"ar\"]")
 	assert.Equal(t, "처음에 \"joke currency\"라고 불리기도 하면서 장난처럼 시작한 도지코인은 일본 개인 시바 이누를 마스코트로 사용합니다. 이 시바견은 인터넷에서 재미로 사용되던 그림이며, 같은 그림이 코인의 로고로 이용되고 있습니다. 이를 통해 도지코인이 재밌고 친근한 가상화폐라는 점을 강조합니다. 실제로 당장 홈페이지만 접속해도, 아주 쉽게 도지코인 지갑을 설치할 수 있습니다. \r\n\r\n개발자 빌리 마커스는 불법 마약 거래 사이트 실크로드에서 사용되는 비트코인과는 달리, 악용되지 않으면서 더 넓은 인구들의 사용을 위해 도지코인을 만든 것이라고 합니다.\r\n\r\n코인 특징\r\n1. 도지코인은 라이트코인에서 포크된 럭키코인에서 포크되었습니다. 그래서 처음에는 럭키코인처럼 채굴보상이 랜덤이었는데, 이후 정해진 보상으로 정책을 바꿨습니다. \r\n\r\n2. 도지코인은 빠른 코인 생산 속도를 가지고 있습니다. 처음에는 생산량이 1,000억 개로 고정돼있었는데, 무제한 생산으로 바뀌었습니다. 현재 10,000개의 코인이 1분마다 생겨나는 중이고, 1년에는 52억 개의 새로운 도지코인이 생겨납니다. 2015년 6월 30일 1,000억 개의 코인이 이미 생산되었습니다. \r\n\r\n3. 도지코인은 SNS에서 팁을 줄 수 있는 시스템을 통해 인기를 얻었습니다. 즉, 도지코인을 이용해 사용자들이 흥미롭거나 가치 있는 콘텐츠를 제공한 사람에게 팁을 주는 것입니다. 레딧, 트위터, 트위치티비(Twitch.TV)등에서 이런 서비스를 제공하는 도지팁봇(Dogetipbot)이 등장하기도 했으나 현재 사용 가능한 팁봇은 제한적입니다. ", coin.Description["ko"], "coin.Description[\"ko\"]")
 	assert.Equal(t, "dogecoin", (*coin.Links)["twitter_screen_name"], "coin.Links[\"twitter_screen_name\"]")
-	assert.Equal(t, "2013-12-08", coin.GenesisDate, "coin.GenesisDate")
+	assert.Equal(t, time.Date(2013, time.December, 8, 0, 0, 0, 0, time.UTC), coin.GenesisDate.Time, "coin.GenesisDate")
+	assert.Equal(t, time.Date(2023, time.January, 6, 16, 7, 11, 284000000, time.UTC), coin.LastUpdated.Time, "coin.LastUpdated")
+	if assert.NotNil(t, coin.MarketData, "coin.MarketData") {
+		assert.Equal(t, time.Date(2021, time.May, 8, 5, 8, 23, 458000000, time.UTC), coin.MarketData.ATHDate["aed"].Time, "coin.MarketData.ATHDate[\"aed\"]")
+		assert.False(t, coin.MarketData.ATLDate["usd"].IsZero(), "coin.MarketData.ATLDate[\"usd\"]")
+		assert.Equal(t, time.Date(2023, time.January, 6, 16, 7, 11, 284000000, time.UTC), coin.MarketData.LastUpdated.Time, "coin.MarketData.LastUpdated")
+	}
 	assert.Equal(t, 8, int(coin.MarketCapRank), "coin.MarketCapRank")
 	assert.Equal(t, 6, int(coin.CoinGeckoRank), "coin.CoinGeckoRank")
 }
 
+func TestCoinsIDParams_encodeNonIDQueryParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		params CoinsIDParams
+		want   string
+	}{
+		{
+			"all defaults",
+			CoinsIDParams{CoinID: "bitcoin", Localization: true, Tickers: true, MarketData: true, CommunityData: true, DeveloperData: true},
+			"",
+		},
+		{
+			"zero value",
+			CoinsIDParams{CoinID: "bitcoin"},
+			"community_data=false&developer_data=false&localization=false&market_data=false&tickers=false",
+		},
+		{
+			"non defaults",
+			CoinsIDParams{
+				CoinID:                   "uniswap",
+				Tickers:                  true,
+				MarketData:               true,
+				Sparkline:                true,
+				IncludeCategoriesDetails: true,
+				DexPairFormat:            types.DexPairFormatSymbol,
+			},
+			"community_data=false&developer_data=false&dex_pair_format=symbol&include_categories_details=true&" +
+				"localization=false&sparkline=true",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.params.encodeNonIDQueryParams())
+		})
+	}
+}
+
+func TestCoinsIDParams_Validate(t *testing.T) {
+	assert.NoError(t, CoinsIDParams{CoinID: "bitcoin", DexPairFormat: types.DexPairFormatSymbol}.Validate())
+	assert.Error(t, CoinsIDParams{}.Validate(), "missing CoinID")
+	assert.Error(t, CoinsIDParams{CoinID: "bitcoin", DexPairFormat: 2}.Validate(), "DexPairFormat")
+	assert.Error(t, CoinsIDParams{CoinID: "bitcoin", DexPairFormat: -1}.Validate(), "negative DexPairFormat")
+}
+
+func TestCoinsIDTickersParam_encodeNonIDQueryParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		params CoinsIDTickersParam
+		want   string
+	}{
+		{
+			"defaults",
+			CoinsIDTickersParam{CoinsID: "bitcoin", PageNo: 1},
+			"",
+		},
+		{
+			"non defaults",
+			CoinsIDTickersParam{
+				CoinsID:                "uniswap",
+				ExchangeIDs:            []string{"uniswap_v3", "binance"},
+				ExchangeLogo:           true,
+				PageNo:                 2,
+				Order:                  types.TickerOrderVolumeAsc,
+				Show2PctOrderBookDepth: true,
+				DexPairFormat:          types.DexPairFormatSymbol,
+			},
+			"depth=true&dex_pair_format=symbol&exchange_ids=uniswap_v3%2Cbinance&include_exchange_logo=true&" +
+				"order=volume_asc&page=2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.params.encodeNonIDQueryParams())
+		})
+	}
+}
+
+func TestCoinsIDTickersParam_Validate(t *testing.T) {
+	assert.NoError(t, CoinsIDTickersParam{CoinsID: "bitcoin", Order: types.TickerOrderVolumeAsc, DexPairFormat: types.DexPairFormatSymbol}.Validate())
+	assert.Error(t, CoinsIDTickersParam{}.Validate(), "missing CoinsID")
+	assert.Error(t, CoinsIDTickersParam{CoinsID: "bitcoin", Order: 4}.Validate(), "Order")
+	assert.Error(t, CoinsIDTickersParam{CoinsID: "bitcoin", Order: -1}.Validate(), "negative Order")
+	assert.Error(t, CoinsIDTickersParam{CoinsID: "bitcoin", DexPairFormat: -1}.Validate(), "DexPairFormat")
+}
+
 func TestClient_CoinsIDTickers(t *testing.T) {
 	err := setupGock("json/coins_id_tickers.json", "json/common_page.headers.json", "/coins/bitcoin/tickers")
 	require.NoError(t, err)
@@ -127,6 +327,8 @@ func TestClient_CoinsIDTickers(t *testing.T) {
 	first := tickers[0]
 	assert.Equal(t, "binance", first.Market.Identifier, "tickers[0].Market.Identifier")
 	assert.Equal(t, 16923.83, first.Last, "tickers[0].Last")
+	assert.Equal(t, "16923.83", first.LastDecimal.String(), "tickers[0].LastDecimal")
+	assert.Equal(t, "178831.36767623396", first.VolumeDecimal.String(), "tickers[0].VolumeDecimal")
 	assert.Equal(t, 178831.36767623396, first.Volume, "tickers[0].Volume")
 	assert.Equal(t, 20584847.5882314, *first.CostToMoveUpUsd, "tickers[0].CostToMoveUpUsd")
 	assert.Equal(t, 22770501.2269016, *first.CostToMoveDownUsd, "tickers[0].CostToMoveDownUsd")
@@ -144,7 +346,7 @@ func TestClient_CoinsIDHistory(t *testing.T) {
 
 	history, err := c.CoinsIDHistory(CoinsIDHistoryParams{
 		CoinID:       "bitcoin",
-		SnapshotDate: "06-01-2022",
+		SnapshotDate: time.Date(2022, time.January, 6, 12, 0, 0, 0, time.UTC),
 		Localization: true,
 	})
 	require.NoError(t, err)
@@ -163,6 +365,106 @@ func TestClient_CoinsIDHistory(t *testing.T) {
 	assert.Equal(t, 31111, int(*history.DeveloperData.Forks), "history.DeveloperData.Forks")
 }
 
+func TestCoinsIDHistoryParams_encodeNonIDQueryParams(t *testing.T) {
+	// 2022-01-06 23:30 in UTC-5 is 2022-01-07 in UTC
+	date := time.Date(2022, time.January, 6, 23, 30, 0, 0, time.FixedZone("EST", -5*60*60))
+	assert.Equal(t, "date=07-01-2022&localization=false", CoinsIDHistoryParams{CoinID: "bitcoin", SnapshotDate: date}.encodeNonIDQueryParams())
+}
+
+func TestCoinsIDHistoryParams_Validate(t *testing.T) {
+	genesis := time.Date(2009, time.January, 3, 0, 0, 0, 0, time.UTC)
+	assert.NoError(t, CoinsIDHistoryParams{CoinID: "bitcoin", SnapshotDate: time.Date(2022, time.January, 6, 0, 0, 0, 0, time.UTC)}.Validate())
+	assert.NoError(t, CoinsIDHistoryParams{CoinID: "bitcoin", SnapshotDate: time.Now()}.Validate(), "today")
+	assert.NoError(t, CoinsIDHistoryParams{CoinID: "bitcoin", SnapshotDate: genesis.Add(time.Hour), GenesisDate: genesis}.Validate(), "genesis day")
+	assert.Error(t, CoinsIDHistoryParams{SnapshotDate: time.Now()}.Validate(), "missing CoinID")
+	assert.Error(t, CoinsIDHistoryParams{CoinID: "bitcoin"}.Validate(), "missing SnapshotDate")
+	assert.Error(t, CoinsIDHistoryParams{CoinID: "bitcoin", SnapshotDate: time.Now().AddDate(0, 0, 2)}.Validate(), "future")
+	assert.Error(t, CoinsIDHistoryParams{CoinID: "bitcoin", SnapshotDate: genesis.AddDate(0, 0, -1), GenesisDate: genesis}.Validate(), "before genesis")
+}
+
+func TestClient_CoinsIDHistoryRange(t *testing.T) {
+	// The second day is rate limited once
+	gock.New(mockURL).
+		Get("/coins/bitcoin/history").
+		MatchParam("date", "02-01-2022").
+		Reply(http.StatusTooManyRequests).
+		SetHeader("Retry-After", "0").
+		BodyString(`{"status":{"error_code":429,"error_message":"You've exceeded the Rate Limit."}}`)
+	for _, date := range []string{"01-01-2022", "02-01-2022", "03-01-2022"} {
+		gock.New(mockURL).
+			Get("/coins/bitcoin/history").
+			MatchParam("date", date).
+			Reply(http.StatusOK).
+			File("json/coins_id_history.json")
+	}
+
+	from := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+	snapshots, err := c.CoinsIDHistoryRange(CoinsIDHistoryRangeParams{
+		CoinID:      "bitcoin",
+		From:        from,
+		To:          from.AddDate(0, 0, 2),
+		Concurrency: 2,
+	})
+	require.NoError(t, err)
+	assert.True(t, gock.IsDone(), "gock.IsDone")
+
+	require.Len(t, snapshots, 3)
+	for i, snapshot := range snapshots {
+		require.NotNil(t, snapshot, "snapshots[%d]", i)
+		assert.Equal(t, "bitcoin", snapshot.ID, "snapshots[%d].ID", i)
+	}
+}
+
+func TestClient_CoinsIDHistoryRange_requestsPerMinute(t *testing.T) {
+	for _, date := range []string{"01-01-2022", "02-01-2022", "03-01-2022"} {
+		gock.New(mockURL).
+			Get("/coins/bitcoin/history").
+			MatchParam("date", date).
+			Reply(http.StatusOK).
+			File("json/coins_id_history.json")
+	}
+
+	from := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+	start := time.Now()
+	snapshots, err := c.CoinsIDHistoryRange(CoinsIDHistoryRangeParams{
+		CoinID:            "bitcoin",
+		From:              from,
+		To:                from.AddDate(0, 0, 2),
+		Concurrency:       3,
+		RequestsPerMinute: 1200,
+	})
+	require.NoError(t, err)
+	assert.Len(t, snapshots, 3)
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond, "3 requests spaced by 50ms")
+}
+
+func TestRateLimiter_wait(t *testing.T) {
+	l := &rateLimiter{}
+	l.pause(30 * time.Millisecond)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		l.pause(60 * time.Millisecond)
+	}()
+
+	start := time.Now()
+	l.wait()
+	assert.GreaterOrEqual(t, time.Since(start), 70*time.Millisecond, "pause recorded while waiting is honoured")
+}
+
+func TestClient_CoinsIDHistoryRange_error(t *testing.T) {
+	setupGockError("/coins/bitcoin/history", http.StatusNotFound, `{"error":"coin not found"}`)
+
+	from := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+	_, err := c.CoinsIDHistoryRange(CoinsIDHistoryRangeParams{
+		CoinID: "bitcoin",
+		From:   from,
+		To:     from,
+	})
+	var rErr *ResponseError
+	require.ErrorAs(t, err, &rErr)
+	assert.Equal(t, http.StatusNotFound, rErr.StatusCode, "rErr.StatusCode")
+}
+
 func TestClient_CoinsIDMarketChart(t *testing.T) {
 	err := setupGock("json/coins_id_market_chart.json", "json/common.headers.json", "/coins/bitcoin/market_chart")
 	require.NoError(t, err)
@@ -178,7 +480,478 @@ func TestClient_CoinsIDMarketChart(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, mc)
 
+	assert.Equal(t, types.MarketChartGranularityFiveMinutes, mc.Granularity, "mc.Granularity")
 	assert.Len(t, mc.Prices, 290, "mc.Prices")
 	assert.Len(t, mc.MarketCaps, 290, "mc.MarketCaps")
 	assert.Len(t, mc.TotalVolumes, 290, "mc.TotalVolumes")
 }
+
+func TestClient_CoinsIDMarketChart_interval(t *testing.T) {
+	gock.New(proMockURL).
+		Get("/coins/bitcoin/market_chart").
+		MatchParams(map[string]string{
+			"days":      "^30$",
+			"interval":  "^hourly$",
+			"precision": "^full$",
+		}).
+		Reply(http.StatusOK).
+		File("json/coins_id_market_chart.json")
+
+	mc, err := proC.CoinsIDMarketChart(CoinsIDMarketChartParams{
+		CoinsID:    "bitcoin",
+		VsCurrency: "usd",
+		Days:       "30",
+		Interval:   types.MarketChartGranularityHourly,
+		Precision:  "full",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, mc)
+	assert.True(t, gock.IsDone(), "gock.IsDone")
+
+	assert.Equal(t, types.MarketChartGranularityHourly, mc.Granularity, "mc.Granularity")
+
+	_, err = c.CoinsIDMarketChart(CoinsIDMarketChartParams{
+		CoinsID:    "bitcoin",
+		VsCurrency: "usd",
+		Days:       "1",
+		Interval:   types.MarketChartGranularityFiveMinutes,
+	})
+	assert.ErrorIs(t, err, ErrProPlanRequired)
+}
+
+func TestCoinsIDMarketChartParams_granularity(t *testing.T) {
+	tests := []struct {
+		days     string
+		interval types.MarketChartGranularity
+		want     types.MarketChartGranularity
+	}{
+		{"1", types.MarketChartGranularityAuto, types.MarketChartGranularityFiveMinutes},
+		{"2", types.MarketChartGranularityAuto, types.MarketChartGranularityHourly},
+		{"90", types.MarketChartGranularityAuto, types.MarketChartGranularityHourly},
+		{"91", types.MarketChartGranularityAuto, types.MarketChartGranularityDaily},
+		{"max", types.MarketChartGranularityAuto, types.MarketChartGranularityDaily},
+		{"1", types.MarketChartGranularityDaily, types.MarketChartGranularityDaily},
+	}
+	for _, tt := range tests {
+		t.Run(tt.days+" "+tt.interval.String(), func(t *testing.T) {
+			p := CoinsIDMarketChartParams{CoinsID: "bitcoin", VsCurrency: "usd", Days: tt.days, Interval: tt.interval}
+			assert.Equal(t, tt.want, p.granularity())
+		})
+	}
+}
+
+func TestCoinsIDMarketChartParams_Validate(t *testing.T) {
+	valid := CoinsIDMarketChartParams{CoinsID: "bitcoin", VsCurrency: "usd", Days: "max", Interval: types.MarketChartGranularityDaily, Precision: "2"}
+	assert.NoError(t, valid.Validate())
+
+	for _, days := range []string{"", "0", "-1", "1.5", "week"} {
+		p := valid
+		p.Days = days
+		assert.Error(t, p.Validate(), "Days %q", days)
+	}
+
+	invalidInterval := valid
+	invalidInterval.Interval = 4
+	assert.Error(t, invalidInterval.Validate(), "Interval")
+
+	fiveMinutes := valid
+	fiveMinutes.Interval = types.MarketChartGranularityFiveMinutes
+	fiveMinutes.Days = "1"
+	assert.NoError(t, fiveMinutes.Validate(), "5-minute Interval for 1 day")
+	for _, days := range []string{"2", "max"} {
+		fiveMinutes.Days = days
+		assert.Error(t, fiveMinutes.Validate(), "5-minute Interval for Days %q", days)
+	}
+
+	hourly := valid
+	hourly.Interval = types.MarketChartGranularityHourly
+	for _, days := range []string{"1", "90"} {
+		hourly.Days = days
+		assert.NoError(t, hourly.Validate(), "hourly Interval for Days %q", days)
+	}
+	for _, days := range []string{"91", "max"} {
+		hourly.Days = days
+		assert.Error(t, hourly.Validate(), "hourly Interval for Days %q", days)
+	}
+
+	invalidPrecision := valid
+	invalidPrecision.Precision = "high"
+	assert.Error(t, invalidPrecision.Validate(), "Precision")
+}
+
+func TestClient_CoinsIDContractAddress(t *testing.T) {
+	err := setupGock("json/coins_id_contract_address.json", "json/common.headers.json", "/coins/ethereum/contract/0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48")
+	require.NoError(t, err)
+
+	got, err := c.CoinsIDContractAddress(CoinsIDContractAddressParams{
+		AssetPlatformID: "ethereum",
+		ContractAddress: "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+
+	assert.Equal(t, "usd-coin", got.ID, "got.ID")
+	assert.Equal(t, "usdc", got.Symbol, "got.Symbol")
+	assert.Equal(t, "ethereum", *got.AssetPlatformID, "got.AssetPlatformID")
+	assert.Equal(t, "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", got.ContractAddress, "got.ContractAddress")
+	assert.Equal(t, "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", got.Platforms["solana"], "got.Platforms[\"solana\"]")
+	if ethereum, ok := got.DetailPlatforms["ethereum"]; assert.True(t, ok, "got.DetailPlatforms[\"ethereum\"]") {
+		assert.Equal(t, 6, *ethereum.DecimalPlace, "ethereum.DecimalPlace")
+		assert.Equal(t, "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", ethereum.ContractAddress, "ethereum.ContractAddress")
+	}
+	if assert.NotNil(t, got.MarketData, "got.MarketData") {
+		assert.Equal(t, 1.0, got.MarketData.CurrentPrice["usd"], "got.MarketData.CurrentPrice[\"usd\"]")
+		assert.Equal(t, "5.7e-05", got.MarketData.CurrentPriceDecimal["btc"].String(), "got.MarketData.CurrentPriceDecimal[\"btc\"]")
+		assert.Equal(t, got.MarketData.CurrentPrice, got.MarketData.CurrentPriceDecimal.Float64(), "got.MarketData.CurrentPriceDecimal.Float64()")
+		assert.Equal(t, 44418957946.1744, got.MarketData.CirculatingSupply, "got.MarketData.CirculatingSupply")
+	}
+}
+
+func TestClient_CoinsIDContractAddress_notFound(t *testing.T) {
+	setupGockError("/coins/ethereum/contract/0xdead", http.StatusNotFound, `{"error":"coin not found"}`)
+	err := setupGock("json/asset_platforms.json", "json/common.headers.json", "/asset_platforms")
+	require.NoError(t, err)
+	_, err = c.CoinsIDContractAddress(CoinsIDContractAddressParams{
+		AssetPlatformID: "ethereum",
+		ContractAddress: "0xdead",
+	})
+	assert.ErrorIs(t, err, ErrContractNotFound)
+
+	setupGockError("/coins/unknown/contract/0xdead", http.StatusNotFound, `{"error":"coin not found"}`)
+	err = setupGock("json/asset_platforms.json", "json/common.headers.json", "/asset_platforms")
+	require.NoError(t, err)
+	_, err = c.CoinsIDContractAddress(CoinsIDContractAddressParams{
+		AssetPlatformID: "unknown",
+		ContractAddress: "0xdead",
+	})
+	assert.ErrorIs(t, err, ErrAssetPlatformNotFound)
+
+	setupGockError("/coins/unknown/contract/0xbeef", http.StatusNotFound, `{"error":"coin not found"}`)
+	setupGockError("/asset_platforms", http.StatusTooManyRequests, `{"status":{"error_code":429}}`)
+	_, err = c.CoinsIDContractAddress(CoinsIDContractAddressParams{
+		AssetPlatformID: "unknown",
+		ContractAddress: "0xbeef",
+	})
+	var rErr *ResponseError
+	if assert.ErrorAs(t, err, &rErr) {
+		assert.Equal(t, http.StatusNotFound, rErr.StatusCode, "lookup failure keeps the original error")
+	}
+
+	setupGockError("/coins/ethereum/contract/0xbeef", http.StatusTooManyRequests, `{"status":{"error_code":429}}`)
+	_, err = c.CoinsIDContractAddress(CoinsIDContractAddressParams{
+		AssetPlatformID: "ethereum",
+		ContractAddress: "0xbeef",
+	})
+	if assert.ErrorAs(t, err, &rErr) {
+		assert.Equal(t, http.StatusTooManyRequests, rErr.StatusCode)
+	}
+}
+
+func TestClient_CoinsIDMarketChartRange(t *testing.T) {
+	err := setupGock("json/coins_id_market_chart_range.json", "json/common.headers.json", "/coins/bitcoin/market_chart/range")
+	require.NoError(t, err)
+
+	mc, err := c.CoinsIDMarketChartRange(CoinsIDMarketChartRangeParams{
+		CoinsID:    "bitcoin",
+		VsCurrency: "usd",
+		From:       time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:         time.Date(2023, time.January, 3, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, mc)
+
+	assert.Equal(t, commonBaseResult, mc.BaseResult)
+	assert.Equal(t, types.MarketChartGranularityHourly, mc.Granularity, "mc.Granularity")
+
+	if assert.Len(t, mc.Prices, 3, "mc.Prices") {
+		assert.Equal(t, time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC), mc.Prices[0].Time.UTC(), "mc.Prices[0].Time")
+		assert.Equal(t, 16547.49605246, mc.Prices[0].Value, "mc.Prices[0].Value")
+	}
+	assert.Len(t, mc.MarketCaps, 3, "mc.MarketCaps")
+	assert.Len(t, mc.TotalVolumes, 3, "mc.TotalVolumes")
+}
+
+func TestClient_CoinsIDMarketChartRange_chunks(t *testing.T) {
+	from := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	mid := from.Add(18 * time.Hour)
+	to := mid.Add(18 * time.Hour)
+
+	gock.New(mockURL).
+		Get("/coins/bitcoin/market_chart/range").
+		MatchParam("from", strconv.FormatInt(from.Unix(), 10)).
+		MatchParam("to", strconv.FormatInt(mid.Unix(), 10)).
+		Reply(http.StatusOK).
+		BodyString(`{"prices":[[1672531200000,1],[1672617600000,2]],"market_caps":[[1672617600000,20]],"total_volumes":[]}`)
+	gock.New(mockURL).
+		Get("/coins/bitcoin/market_chart/range").
+		MatchParam("from", strconv.FormatInt(mid.Unix(), 10)).
+		MatchParam("to", strconv.FormatInt(to.Unix(), 10)).
+		Reply(http.StatusOK).
+		BodyString(`{"prices":[[1672617600000,2],[1672660800000,3]],"market_caps":[[1672617600000,20],[1672660800000,30]],"total_volumes":[[1672660800000,300]]}`)
+
+	mc, err := c.CoinsIDMarketChartRange(CoinsIDMarketChartRangeParams{
+		CoinsID:     "bitcoin",
+		VsCurrency:  "usd",
+		From:        from,
+		To:          to,
+		Granularity: types.MarketChartGranularityFiveMinutes,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, mc)
+	assert.True(t, gock.IsDone(), "all chunks requested")
+	assert.Equal(t, types.MarketChartGranularityFiveMinutes, mc.Granularity, "mc.Granularity")
+
+	if assert.Len(t, mc.Prices, 3, "mc.Prices") {
+		assert.Equal(t, 1.0, mc.Prices[0].Value, "mc.Prices[0].Value")
+		assert.Equal(t, 2.0, mc.Prices[1].Value, "mc.Prices[1].Value")
+		assert.Equal(t, 3.0, mc.Prices[2].Value, "mc.Prices[2].Value")
+	}
+	assert.Len(t, mc.MarketCaps, 2, "mc.MarketCaps")
+	assert.Len(t, mc.TotalVolumes, 1, "mc.TotalVolumes")
+}
+
+func TestClient_CoinsIDMarketChartRange_unevenChunks(t *testing.T) {
+	from := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(91 * 24 * time.Hour)
+	mid := from.Add(91 * 12 * time.Hour)
+
+	gock.New(mockURL).
+		Get("/coins/bitcoin/market_chart/range").
+		MatchParam("from", strconv.FormatInt(from.Unix(), 10)).
+		MatchParam("to", strconv.FormatInt(mid.Unix(), 10)).
+		Reply(http.StatusOK).
+		BodyString(fmt.Sprintf(`{"prices":[[%d,1],[%d,2],[%d,3]],"market_caps":[],"total_volumes":[]}`,
+			from.UnixMilli(), from.Add(5*time.Minute).UnixMilli(), from.Add(time.Hour).UnixMilli()))
+	gock.New(mockURL).
+		Get("/coins/bitcoin/market_chart/range").
+		MatchParam("from", strconv.FormatInt(mid.Unix(), 10)).
+		MatchParam("to", strconv.FormatInt(to.Unix(), 10)).
+		Reply(http.StatusOK).
+		BodyString(fmt.Sprintf(`{"prices":[[%d,4],[%d,5]],"market_caps":[],"total_volumes":[]}`,
+			mid.UnixMilli(), to.UnixMilli()))
+
+	mc, err := c.CoinsIDMarketChartRange(CoinsIDMarketChartRangeParams{
+		CoinsID:     "bitcoin",
+		VsCurrency:  "usd",
+		From:        from,
+		To:          to,
+		Granularity: types.MarketChartGranularityHourly,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, mc)
+	assert.True(t, gock.IsDone(), "both 45.5 days chunks requested")
+	assert.Equal(t, types.MarketChartGranularityHourly, mc.Granularity, "mc.Granularity")
+
+	var values []float64
+	for _, price := range mc.Prices {
+		values = append(values, price.Value)
+	}
+	assert.Equal(t, []float64{1, 3, 4, 5}, values, "mc.Prices resampled hourly")
+}
+
+func TestClient_CoinsIDMarketChartRange_daily(t *testing.T) {
+	from := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(48 * time.Hour)
+
+	gock.New(mockURL).
+		Get("/coins/bitcoin/market_chart/range").
+		MatchParam("from", strconv.FormatInt(from.Unix(), 10)).
+		MatchParam("to", strconv.FormatInt(to.Unix(), 10)).
+		Reply(http.StatusOK).
+		BodyString(fmt.Sprintf(`{"prices":[[%d,1],[%d,2],[%d,3],[%d,4],[%d,5]],"market_caps":[],"total_volumes":[]}`,
+			from.UnixMilli(), from.Add(time.Hour).UnixMilli(), from.Add(24*time.Hour).UnixMilli(),
+			from.Add(29*time.Hour).UnixMilli(), to.UnixMilli()))
+
+	mc, err := c.CoinsIDMarketChartRange(CoinsIDMarketChartRangeParams{
+		CoinsID:     "bitcoin",
+		VsCurrency:  "usd",
+		From:        from,
+		To:          to,
+		Granularity: types.MarketChartGranularityDaily,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, mc)
+	assert.Equal(t, types.MarketChartGranularityDaily, mc.Granularity, "mc.Granularity")
+
+	var values []float64
+	for _, price := range mc.Prices {
+		values = append(values, price.Value)
+	}
+	assert.Equal(t, []float64{1, 3, 5}, values, "mc.Prices resampled daily")
+}
+
+func TestCoinsIDMarketChartRangeParams_Validate(t *testing.T) {
+	from := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	valid := CoinsIDMarketChartRangeParams{CoinsID: "bitcoin", VsCurrency: "usd", From: from, To: from.Add(time.Hour)}
+	assert.NoError(t, valid.Validate())
+
+	reversed := valid
+	reversed.From, reversed.To = valid.To, valid.From
+	assert.Error(t, reversed.Validate())
+
+	noTo := valid
+	noTo.To = time.Time{}
+	assert.Error(t, noTo.Validate())
+}
+
+func TestClient_CoinsIDOHLC(t *testing.T) {
+	err := setupGock("json/coins_id_ohlc.json", "json/common.headers.json", "/coins/bitcoin/ohlc")
+	require.NoError(t, err)
+
+	ohlc, err := c.CoinsIDOHLC(CoinsIDOHLCParams{
+		CoinsID:    "bitcoin",
+		VsCurrency: "usd",
+		Days:       "1",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, ohlc)
+
+	assert.Equal(t, commonBaseResult, ohlc.BaseResult)
+
+	require.Len(t, ohlc.Candles, 4, "ohlc.Candles")
+	first := ohlc.Candles[0]
+	assert.Equal(t, time.Date(2023, time.January, 10, 8, 0, 0, 0, time.UTC), first.Time.UTC(), "first.Time")
+	assert.Equal(t, 17189.01, first.Open, "first.Open")
+	assert.Equal(t, 17228.32, first.High, "first.High")
+	assert.Equal(t, 17167.26, first.Low, "first.Low")
+	assert.Equal(t, 17196.61, first.Close, "first.Close")
+}
+
+func TestClient_CoinsIDOHLC_interval(t *testing.T) {
+	gock.New(proMockURL).
+		Get("/coins/bitcoin/ohlc").
+		MatchParam("interval", "^hourly$").
+		Reply(http.StatusOK).
+		File("json/coins_id_ohlc.json")
+
+	ohlc, err := proC.CoinsIDOHLC(CoinsIDOHLCParams{
+		CoinsID:    "bitcoin",
+		VsCurrency: "usd",
+		Days:       "1",
+		Interval:   types.OHLCIntervalHourly,
+	})
+	require.NoError(t, err)
+	assert.True(t, gock.IsDone(), "gock.IsDone")
+	assert.Len(t, ohlc.Candles, 4, "ohlc.Candles")
+
+	_, err = c.CoinsIDOHLC(CoinsIDOHLCParams{
+		CoinsID:    "bitcoin",
+		VsCurrency: "usd",
+		Days:       "1",
+		Interval:   types.OHLCIntervalDaily,
+	})
+	assert.ErrorIs(t, err, ErrProPlanRequired)
+
+	setupProGockError("/coins/bitcoin/ohlc", http.StatusUnauthorized, `{"status":{"error_code":10005,"error_message":"You need a higher plan to access this endpoint."}}`)
+	_, err = proC.CoinsIDOHLC(CoinsIDOHLCParams{
+		CoinsID:    "bitcoin",
+		VsCurrency: "usd",
+		Days:       "1",
+		Interval:   types.OHLCIntervalDaily,
+	})
+	assert.ErrorIs(t, err, ErrProPlanRequired)
+}
+
+func TestCoinsIDOHLCParams_Validate(t *testing.T) {
+	for _, days := range []string{"1", "365", "max"} {
+		assert.NoError(t, CoinsIDOHLCParams{CoinsID: "bitcoin", VsCurrency: "usd", Days: days}.Validate(), "Days %q", days)
+	}
+
+	for _, days := range []string{"", "2"} {
+		assert.Error(t, CoinsIDOHLCParams{CoinsID: "bitcoin", VsCurrency: "usd", Days: days}.Validate(), "Days %q", days)
+	}
+}
+
+func TestClient_CoinsListNew(t *testing.T) {
+	err := setupProGock("json/coins_list_new.json", "json/common.headers.json", "/coins/list/new")
+	require.NoError(t, err)
+
+	got, err := proC.CoinsListNew()
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+
+	require.Len(t, got.Coins, 2)
+	assert.Equal(t, "long-johnson", got.Coins[0].ID, "got.Coins[0].ID")
+	assert.Equal(t, "olong", got.Coins[0].Symbol, "got.Coins[0].Symbol")
+	assert.Equal(t, "Long Johnson", got.Coins[0].Name, "got.Coins[0].Name")
+	assert.Equal(t, time.Date(2023, time.January, 11, 8, 44, 49, 0, time.UTC), got.Coins[0].ActivatedAt.Time, "got.Coins[0].ActivatedAt")
+}
+
+func TestClient_CoinsListNew_notPro(t *testing.T) {
+	_, err := c.CoinsListNew()
+	assert.ErrorIs(t, err, ErrProPlanRequired)
+}
+
+func TestClient_CoinsTopGainersLosers(t *testing.T) {
+	err := setupProGock("json/coins_top_gainers_losers.json", "json/common.headers.json", "/coins/top_gainers_losers")
+	require.NoError(t, err)
+
+	got, err := proC.CoinsTopGainersLosers(CoinsTopGainersLosersParams{
+		VsCurrency: "usd",
+		Duration:   types.TopGainersLosersDuration24H,
+		TopCoins:   types.TopGainersLosersTopCoins300,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+
+	require.Len(t, got.TopGainers, 2)
+	bonk := got.TopGainers[0]
+	assert.Equal(t, "bonk", bonk.ID, "bonk.ID")
+	assert.Equal(t, "Bonk", bonk.Name, "bonk.Name")
+	assert.Equal(t, 152, *bonk.MarketCapRank, "bonk.MarketCapRank")
+	assert.Equal(t, 0.000002843, *bonk.Price, "bonk.Price")
+	assert.Equal(t, 110349282.57, *bonk.Volume24h, "bonk.Volume24h")
+	assert.Equal(t, 71.23, *bonk.PriceChangePercentage, "bonk.PriceChangePercentage")
+
+	require.Len(t, got.TopLosers, 1)
+	fxs := got.TopLosers[0]
+	assert.Equal(t, "frax-share", fxs.ID, "fxs.ID")
+	assert.Nil(t, fxs.MarketCapRank, "fxs.MarketCapRank")
+	assert.Nil(t, fxs.Volume24h, "fxs.Volume24h")
+	assert.Equal(t, -12.87, *fxs.PriceChangePercentage, "fxs.PriceChangePercentage")
+}
+
+func TestClient_CoinsTopGainersLosers_notPro(t *testing.T) {
+	_, err := c.CoinsTopGainersLosers(CoinsTopGainersLosersParams{VsCurrency: "usd"})
+	assert.ErrorIs(t, err, ErrProPlanRequired)
+}
+
+func TestClient_CoinsTopGainersLosers_planRestricted(t *testing.T) {
+	setupProGockError("/coins/top_gainers_losers", http.StatusUnauthorized, `{"status":{"error_code":10005,"error_message":"You need a higher plan to access this endpoint."}}`)
+
+	_, err := proC.CoinsTopGainersLosers(CoinsTopGainersLosersParams{VsCurrency: "usd"})
+	assert.ErrorIs(t, err, ErrProPlanRequired)
+
+	setupProGockError("/coins/top_gainers_losers", http.StatusForbidden, `{"error":{"status":{"error_code":10010,"error_message":"Invalid API key"}}}`)
+
+	_, err = proC.CoinsTopGainersLosers(CoinsTopGainersLosersParams{VsCurrency: "usd"})
+	assert.ErrorIs(t, err, ErrInvalidAPIKey)
+	assert.NotErrorIs(t, err, ErrProPlanRequired)
+
+	setupProGockError("/coins/top_gainers_losers", http.StatusForbidden, `{"error":"Forbidden"}`)
+
+	_, err = proC.CoinsTopGainersLosers(CoinsTopGainersLosersParams{VsCurrency: "usd"})
+	var rErr *ResponseError
+	if assert.ErrorAs(t, err, &rErr) {
+		assert.Equal(t, http.StatusForbidden, rErr.StatusCode)
+	}
+	assert.NotErrorIs(t, err, ErrProPlanRequired)
+}
+
+func TestClient_CoinsTopGainersLosers_withProPlan(t *testing.T) {
+	err := setupProGock("json/coins_top_gainers_losers.json", "json/common.headers.json", "/coins/top_gainers_losers")
+	require.NoError(t, err)
+
+	withProPlan := NewClient(nil, WithProPlan(), WithHttpRequestModifier(func(r *http.Request) {
+		r.Header.Set("x-cg-pro-api-key", "pro-api-key")
+	}))
+	got, err := withProPlan.CoinsTopGainersLosers(CoinsTopGainersLosersParams{VsCurrency: "usd"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, got.TopGainers, "got.TopGainers")
+}
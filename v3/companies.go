@@ -0,0 +1,39 @@
+package coingecko
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/edward-yakop/go-gecko/v3/types"
+	"strings"
+)
+
+var companiesPublicTreasuryCoinIDs = []string{"bitcoin", "ethereum"}
+
+// CompaniesPublicTreasury /companies/public_treasury/{coin_id}. coinID must either be bitcoin or ethereum.
+func (c *Client) CompaniesPublicTreasury(coinID string) (*types.CompaniesPublicTreasury, error) {
+	validCoinID := false
+	for _, id := range companiesPublicTreasuryCoinIDs {
+		if coinID == id {
+			validCoinID = true
+			break
+		}
+	}
+	if !validCoinID {
+		return nil, fmt.Errorf("coinID must be one of %s", strings.Join(companiesPublicTreasuryCoinIDs, ","))
+	}
+
+	publicTreasuryURL := fmt.Sprintf("%s/companies/public_treasury/%s", c.baseURL, coinID)
+	resp, header, err := c.makeHTTPRequest(publicTreasuryURL)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &types.CompaniesPublicTreasury{
+		BaseResult: types.NewBaseResult(header),
+	}
+	if err = json.Unmarshal(resp, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,37 @@
+package coingecko
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestClient_CompaniesPublicTreasury(t *testing.T) {
+	err := setupGock("json/companies_public_treasury.json", "json/common.headers.json", "/companies/public_treasury/bitcoin")
+	require.NoError(t, err)
+
+	got, err := c.CompaniesPublicTreasury("bitcoin")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+
+	assert.Equal(t, 231347.1204, got.TotalHoldings, "got.TotalHoldings")
+	assert.Equal(t, 4034189562.6893, got.TotalValueUsd, "got.TotalValueUsd")
+	assert.Equal(t, 1.19, got.MarketCapDominance, "got.MarketCapDominance")
+
+	require.Len(t, got.Companies, 2)
+	mstr := got.Companies[0]
+	assert.Equal(t, "MicroStrategy Inc.", mstr.Name, "mstr.Name")
+	assert.Equal(t, "NASDAQ:MSTR", mstr.Symbol, "mstr.Symbol")
+	assert.Equal(t, "US", mstr.Country, "mstr.Country")
+	assert.Equal(t, 132500.0, mstr.TotalHoldings, "mstr.TotalHoldings")
+	assert.Equal(t, 4030000000.0, mstr.TotalEntryValueUsd, "mstr.TotalEntryValueUsd")
+	assert.Equal(t, 2310540532.0, mstr.TotalCurrentValueUsd, "mstr.TotalCurrentValueUsd")
+	assert.Equal(t, 0.631, mstr.PercentageOfTotalSupply, "mstr.PercentageOfTotalSupply")
+}
+
+func TestClient_CompaniesPublicTreasury_unsupportedCoinID(t *testing.T) {
+	_, err := c.CompaniesPublicTreasury("dogecoin")
+	assert.Error(t, err)
+}
@@ -0,0 +1,139 @@
+package coingecko
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/edward-yakop/go-gecko/format"
+	"github.com/edward-yakop/go-gecko/v3/types"
+	"net/url"
+)
+
+// Derivatives /derivatives
+func (c *Client) Derivatives() (*types.Derivatives, error) {
+	derivativesURL := fmt.Sprintf("%s/derivatives", c.baseURL)
+	resp, header, err := c.makeHTTPRequest(derivativesURL)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &types.Derivatives{
+		BaseResult:  types.NewBaseResult(header),
+		Derivatives: []types.DerivativeItem{},
+	}
+	if err = json.Unmarshal(resp, &data.Derivatives); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+type DerivativesExchangesParams struct {
+	Order    types.DerivativesExchangesOrder `json:"order"`     // Default to DerivativesExchangesOrderOpenInterestBtcDesc
+	PageSize int                             `json:"page_size"` // Total results per page. When < 1, default to 100.
+	PageNo   int                             `json:"page_no"`   // Page through results. When < 1, default to 1.
+}
+
+func (p DerivativesExchangesParams) Valid() error {
+	if !p.Order.Valid() {
+		return fmt.Errorf("invalid Order %d", p.Order)
+	}
+
+	return nil
+}
+
+func (p DerivativesExchangesParams) encodeQueryParams() string {
+	params := url.Values{}
+
+	params.Add("order", p.Order.String())
+
+	if p.PageSize < 1 {
+		p.PageSize = 100
+	}
+	params.Add("per_page", format.Int2String(p.PageSize))
+
+	if p.PageNo < 1 {
+		p.PageNo = 1
+	}
+	params.Add("page", format.Int2String(p.PageNo))
+
+	return params.Encode()
+}
+
+// DerivativesExchanges /derivatives/exchanges
+func (c *Client) DerivativesExchanges(params DerivativesExchangesParams) (*types.DerivativesExchanges, error) {
+	if err := params.Valid(); err != nil {
+		return nil, err
+	}
+
+	derivativesExchangesURL := fmt.Sprintf("%s/derivatives/exchanges?%s", c.baseURL, params.encodeQueryParams())
+	resp, header, err := c.makeHTTPRequest(derivativesExchangesURL)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &types.DerivativesExchanges{
+		BasePageResult: types.NewBasePageResult(header, params.PageNo),
+		Exchanges:      []types.DerivativesExchange{},
+	}
+	if err = json.Unmarshal(resp, &data.Exchanges); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+type DerivativesExchangesIDParams struct {
+	ExchangeID     string                          `json:"exchange_id"`     // Derivatives exchange ID, can be obtained from DerivativesExchanges. Required.
+	IncludeTickers types.DerivativesIncludeTickers `json:"include_tickers"` // Default to DerivativesIncludeTickersNone
+}
+
+func (p DerivativesExchangesIDParams) Valid() error {
+	if p.ExchangeID == "" {
+		return fmt.Errorf("ExchangeID is required")
+	}
+
+	if p.IncludeTickers < types.DerivativesIncludeTickersNone || p.IncludeTickers > types.DerivativesIncludeTickersUnexpired {
+		return fmt.Errorf("invalid IncludeTickers %d", p.IncludeTickers)
+	}
+
+	return nil
+}
+
+func (p DerivativesExchangesIDParams) encodeQueryParamsWithoutExchangeID() string {
+	params := url.Values{}
+
+	if p.IncludeTickers != types.DerivativesIncludeTickersNone {
+		params.Add("include_tickers", p.IncludeTickers.String())
+	}
+
+	return params.Encode()
+}
+
+// DerivativesExchangesID /derivatives/exchanges/{id}
+func (c *Client) DerivativesExchangesID(params DerivativesExchangesIDParams) (*types.DerivativesExchangeDetail, error) {
+	if err := params.Valid(); err != nil {
+		return nil, err
+	}
+
+	derivativesExchangeURL := fmt.Sprintf("%s/derivatives/exchanges/%s", c.baseURL, params.ExchangeID)
+	if query := params.encodeQueryParamsWithoutExchangeID(); query != "" {
+		derivativesExchangeURL += "?" + query
+	}
+
+	resp, header, err := c.makeHTTPRequest(derivativesExchangeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &types.DerivativesExchangeDetail{
+		BaseResult: types.NewBaseResult(header),
+	}
+	if err = json.Unmarshal(resp, data); err != nil {
+		return nil, err
+	}
+	if data.ID == "" {
+		data.ID = params.ExchangeID
+	}
+
+	return data, nil
+}
@@ -0,0 +1,110 @@
+package coingecko
+
+import (
+	"github.com/edward-yakop/go-gecko/v3/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestClient_Derivatives(t *testing.T) {
+	err := setupGock("json/derivatives.json", "json/common.headers.json", "/derivatives")
+	require.NoError(t, err)
+
+	got, err := c.Derivatives()
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+
+	require.Len(t, got.Derivatives, 2)
+
+	perp := got.Derivatives[0]
+	assert.Equal(t, "Binance (Futures)", perp.Market, "perp.Market")
+	assert.Equal(t, "BTCUSDT", perp.Symbol, "perp.Symbol")
+	assert.Equal(t, 17436.1, *perp.Price, "perp.Price")
+	assert.Equal(t, "perpetual", perp.ContractType, "perp.ContractType")
+	assert.Equal(t, 17433.3775, *perp.Index, "perp.Index")
+	assert.Equal(t, -0.0153617312013, *perp.Basis, "perp.Basis")
+	assert.Equal(t, 0.01, *perp.Spread, "perp.Spread")
+	assert.Equal(t, 0.01, *perp.FundingRate, "perp.FundingRate")
+	assert.Equal(t, 2061716018.4, *perp.OpenInterest, "perp.OpenInterest")
+	assert.Equal(t, time.Date(2023, time.January, 11, 8, 44, 49, 0, time.UTC), perp.LastTradedAt.Time, "perp.LastTradedAt")
+	assert.Nil(t, perp.ExpiredAt, "perp.ExpiredAt")
+
+	futures := got.Derivatives[1]
+	assert.Equal(t, "futures", futures.ContractType, "futures.ContractType")
+	assert.Nil(t, futures.Spread, "futures.Spread")
+	if assert.NotNil(t, futures.ExpiredAt, "futures.ExpiredAt") {
+		assert.Equal(t, time.Date(2023, time.March, 31, 8, 0, 0, 0, time.UTC), futures.ExpiredAt.Time, "futures.ExpiredAt")
+	}
+}
+
+func TestClient_DerivativesExchanges(t *testing.T) {
+	err := setupGock("json/derivatives_exchanges.json", "json/common_page.headers.json", "/derivatives/exchanges")
+	require.NoError(t, err)
+
+	got, err := c.DerivativesExchanges(DerivativesExchangesParams{
+		Order: types.DerivativesExchangesOrderTradeVolume24hBtcDesc,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, commonBasePageResult, got.BasePageResult)
+
+	require.Len(t, got.Exchanges, 2)
+
+	binance := got.Exchanges[0]
+	assert.Equal(t, "binance_futures", binance.ID, "binance.ID")
+	assert.Equal(t, "Binance (Futures)", binance.Name, "binance.Name")
+	assert.Equal(t, 279958.61, *binance.OpenInterestBtc, "binance.OpenInterestBtc")
+	assert.Equal(t, 574366.94, *binance.TradeVolume24hBtc, "binance.TradeVolume24hBtc")
+	assert.Equal(t, 330, binance.NumberOfPerpetualPairs, "binance.NumberOfPerpetualPairs")
+	assert.Equal(t, 44, binance.NumberOfFuturesPairs, "binance.NumberOfFuturesPairs")
+	assert.Equal(t, 2019, *binance.YearEstablished, "binance.YearEstablished")
+	assert.Nil(t, binance.Country, "binance.Country")
+}
+
+func TestClient_DerivativesExchanges_invalidOrder(t *testing.T) {
+	_, err := c.DerivativesExchanges(DerivativesExchangesParams{
+		Order: types.DerivativesExchangesOrder(-1),
+	})
+	assert.Error(t, err)
+}
+
+func TestClient_DerivativesExchangesID(t *testing.T) {
+	err := setupGock("json/derivatives_exchanges_id.json", "json/common.headers.json", "/derivatives/exchanges/binance_futures")
+	require.NoError(t, err)
+
+	got, err := c.DerivativesExchangesID(DerivativesExchangesIDParams{
+		ExchangeID:     "binance_futures",
+		IncludeTickers: types.DerivativesIncludeTickersAll,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+
+	assert.Equal(t, "binance_futures", got.ID, "got.ID")
+	assert.Equal(t, "Binance (Futures)", got.Name, "got.Name")
+	assert.Equal(t, 279958.61, *got.OpenInterestBtc, "got.OpenInterestBtc")
+
+	require.Len(t, got.Tickers, 2)
+
+	perp := got.Tickers[0]
+	assert.Equal(t, "BTCUSDT", perp.Symbol, "perp.Symbol")
+	assert.Equal(t, "perpetual", perp.ContractType, "perp.ContractType")
+	assert.Equal(t, 17436.1, *perp.Last, "perp.Last")
+	assert.Equal(t, 17433.3775, *perp.Index, "perp.Index")
+	assert.Equal(t, 0.01, *perp.FundingRate, "perp.FundingRate")
+	assert.Equal(t, 2061716018.4, *perp.OpenInterestUsd, "perp.OpenInterestUsd")
+	assert.Equal(t, 13781906781.84, perp.ConvertedVolume["usd"], "perp.ConvertedVolume[\"usd\"]")
+	assert.Equal(t, 17436.1, perp.ConvertedLast["usd"], "perp.ConvertedLast[\"usd\"]")
+	assert.Nil(t, perp.ExpiredAt, "perp.ExpiredAt")
+
+	futures := got.Tickers[1]
+	if assert.NotNil(t, futures.ExpiredAt, "futures.ExpiredAt") {
+		assert.Equal(t, time.Date(2023, time.March, 31, 8, 0, 0, 0, time.UTC), futures.ExpiredAt.Time, "futures.ExpiredAt")
+	}
+}
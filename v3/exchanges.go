@@ -7,7 +7,9 @@ import (
 	"github.com/edward-yakop/go-gecko/format"
 	"github.com/edward-yakop/go-gecko/v3/types"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type ExchangesParam struct {
@@ -203,3 +205,107 @@ func (c *Client) ExchangesIDTickers(params ExchangesIDTickersParams) (*types.Exc
 
 	return data, nil
 }
+
+var exchangesIDVolumeChartDays = []string{"1", "7", "14", "30", "90", "180", "365"}
+
+type ExchangesIDVolumeChartParams struct {
+	ExchangeID string `json:"exchange_id"` // ExchangeID, can be obtained from ExchangesList. Required.
+	Days       string `json:"days"`        // Data up to number of days ago. Valid values: 1,7,14,30,90,180,365
+}
+
+func (p ExchangesIDVolumeChartParams) Valid() error {
+	if p.ExchangeID == "" {
+		return fmt.Errorf("ExchangeID is required")
+	}
+
+	for _, days := range exchangesIDVolumeChartDays {
+		if p.Days == days {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Days must be one of %s", strings.Join(exchangesIDVolumeChartDays, ","))
+}
+
+// ExchangesIDVolumeChart /exchanges/{id}/volume_chart?days={1,7,14,30,90,180,365}
+func (c *Client) ExchangesIDVolumeChart(params ExchangesIDVolumeChartParams) (*types.ExchangesIDVolumeChart, error) {
+	if err := params.Valid(); err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	values.Add("days", params.Days)
+
+	volumeChartURL := fmt.Sprintf("%s/exchanges/%s/volume_chart?%s", c.baseURL, params.ExchangeID, values.Encode())
+
+	return c.exchangesIDVolumeChart(volumeChartURL)
+}
+
+// maxExchangesIDVolumeChartRange is the longest range served by /exchanges/{id}/volume_chart/range
+const maxExchangesIDVolumeChartRange = 31 * 24 * time.Hour
+
+type ExchangesIDVolumeChartRangeParams struct {
+	ExchangeID string    `json:"exchange_id"` // ExchangeID, can be obtained from ExchangesList. Required.
+	From       time.Time `json:"from"`        // Start of the range. Required.
+	To         time.Time `json:"to"`          // End of the range, at most 31 days after From. Required.
+}
+
+func (p ExchangesIDVolumeChartRangeParams) Valid() error {
+	if p.ExchangeID == "" {
+		return fmt.Errorf("ExchangeID is required")
+	}
+
+	if p.From.IsZero() || p.To.IsZero() {
+		return fmt.Errorf("From and To are required")
+	}
+
+	if !p.From.Before(p.To) {
+		return fmt.Errorf("From must be before To")
+	}
+
+	if p.To.Sub(p.From) > maxExchangesIDVolumeChartRange {
+		return fmt.Errorf("range between From and To must be within 31 days")
+	}
+
+	return nil
+}
+
+// ExchangesIDVolumeChartRange /exchanges/{id}/volume_chart/range?from={unix}&to={unix}. Paid plan only.
+func (c *Client) ExchangesIDVolumeChartRange(params ExchangesIDVolumeChartRangeParams) (*types.ExchangesIDVolumeChart, error) {
+	if err := c.requirePro("/exchanges/{id}/volume_chart/range"); err != nil {
+		return nil, err
+	}
+
+	if err := params.Valid(); err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	values.Add("from", strconv.FormatInt(params.From.Unix(), 10))
+	values.Add("to", strconv.FormatInt(params.To.Unix(), 10))
+
+	volumeChartURL := fmt.Sprintf("%s/exchanges/%s/volume_chart/range?%s", c.baseURL, params.ExchangeID, values.Encode())
+	data, err := c.exchangesIDVolumeChart(volumeChartURL)
+	if err != nil {
+		return nil, toPlanRestrictedError(err, "/exchanges/{id}/volume_chart/range")
+	}
+
+	return data, nil
+}
+
+func (c *Client) exchangesIDVolumeChart(volumeChartURL string) (*types.ExchangesIDVolumeChart, error) {
+	resp, header, err := c.makeHTTPRequest(volumeChartURL)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &types.ExchangesIDVolumeChart{
+		BaseResult: types.NewBaseResult(header),
+		Volumes:    []types.ChartItem{},
+	}
+	if err = json.Unmarshal(resp, &data.Volumes); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
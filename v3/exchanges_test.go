@@ -118,3 +118,70 @@ func TestClient_ExchangesIDTickers(t *testing.T) {
 	assert.Equal(t, "bitcoin", btcUsdt.CoinID, "got.Tickers[0].CoinID")
 	assert.Equal(t, "tether", btcUsdt.TargetCoinID, "got.Tickers[0].TargetCoinID")
 }
+
+func TestClient_ExchangesIDVolumeChart(t *testing.T) {
+	err := setupGock("json/exchanges_id_volume_chart.json", "json/common.headers.json", "/exchanges/binance/volume_chart")
+	require.NoError(t, err)
+
+	got, err := c.ExchangesIDVolumeChart(ExchangesIDVolumeChartParams{
+		ExchangeID: "binance",
+		Days:       "1",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+
+	require.Len(t, got.Volumes, 4, "len(got.Volumes)")
+	assert.Equal(t, time.Date(2023, time.January, 10, 8, 0, 0, 0, time.UTC), got.Volumes[0].Time.UTC(), "got.Volumes[0].Time")
+	assert.Equal(t, 255413.45838431567, got.Volumes[0].Value, "got.Volumes[0].Value")
+}
+
+func TestClient_ExchangesIDVolumeChart_invalidDays(t *testing.T) {
+	_, err := c.ExchangesIDVolumeChart(ExchangesIDVolumeChartParams{
+		ExchangeID: "binance",
+		Days:       "2",
+	})
+	assert.Error(t, err)
+}
+
+func TestClient_ExchangesIDVolumeChartRange(t *testing.T) {
+	err := setupProGock("json/exchanges_id_volume_chart.json", "json/common.headers.json", "/exchanges/binance/volume_chart/range")
+	require.NoError(t, err)
+
+	from := time.Date(2023, time.January, 10, 8, 0, 0, 0, time.UTC)
+	got, err := proC.ExchangesIDVolumeChartRange(ExchangesIDVolumeChartRangeParams{
+		ExchangeID: "binance",
+		From:       from,
+		To:         from.Add(30 * time.Minute),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+	assert.Len(t, got.Volumes, 4, "len(got.Volumes)")
+}
+
+func TestClient_ExchangesIDVolumeChartRange_notPro(t *testing.T) {
+	from := time.Date(2023, time.January, 10, 8, 0, 0, 0, time.UTC)
+	_, err := c.ExchangesIDVolumeChartRange(ExchangesIDVolumeChartRangeParams{
+		ExchangeID: "binance",
+		From:       from,
+		To:         from.Add(30 * time.Minute),
+	})
+	assert.ErrorIs(t, err, ErrProPlanRequired)
+}
+
+func TestExchangesIDVolumeChartRangeParams_Valid(t *testing.T) {
+	from := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	valid := ExchangesIDVolumeChartRangeParams{ExchangeID: "binance", From: from, To: from.AddDate(0, 0, 31)}
+	assert.NoError(t, valid.Valid())
+
+	tooLong := valid
+	tooLong.To = from.AddDate(0, 0, 32)
+	assert.Error(t, tooLong.Valid())
+
+	reversed := valid
+	reversed.From, reversed.To = valid.To, valid.From
+	assert.Error(t, reversed.Valid())
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/edward-yakop/go-gecko/v3/types"
+	"net/url"
 )
 
 // Global https://api.coingecko.com/api/v3/global
@@ -25,3 +26,71 @@ func (c *Client) Global() (*types.Global, error) {
 
 	return data.Data, nil
 }
+
+// GlobalDecentralizedFinanceDefi https://api.coingecko.com/api/v3/global/decentralized_finance_defi
+func (c *Client) GlobalDecentralizedFinanceDefi() (*types.GlobalDecentralizedFinanceDefi, error) {
+	globalDefiURL := fmt.Sprintf("%s/global/decentralized_finance_defi", c.baseURL)
+	resp, header, err := c.makeHTTPRequest(globalDefiURL)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &types.GlobalDecentralizedFinanceDefiResponse{
+		Data: &types.GlobalDecentralizedFinanceDefi{
+			BaseResult: types.NewBaseResult(header),
+		},
+	}
+	if err = json.Unmarshal(resp, &data); err != nil {
+		return nil, err
+	}
+
+	return data.Data, nil
+}
+
+type GlobalMarketCapChartParams struct {
+	Days       string `json:"days"`        // Data up to number of days ago (eg. 1,14,30,max)
+	VsCurrency string `json:"vs_currency"` // The target currency of market cap, default to usd
+}
+
+func (p GlobalMarketCapChartParams) Validate() error {
+	return validateDays(p.Days)
+}
+
+func (p GlobalMarketCapChartParams) encodeQueryParams() string {
+	params := url.Values{}
+
+	params.Add("days", p.Days)
+	if p.VsCurrency != "" {
+		params.Add("vs_currency", p.VsCurrency)
+	}
+
+	return params.Encode()
+}
+
+// GlobalMarketCapChart https://pro-api.coingecko.com/api/v3/global/market_cap_chart. Paid plan only.
+func (c *Client) GlobalMarketCapChart(params GlobalMarketCapChartParams) (*types.GlobalMarketCapChart, error) {
+	if err := c.requirePro("/global/market_cap_chart"); err != nil {
+		return nil, err
+	}
+
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	chartURL := fmt.Sprintf("%s/global/market_cap_chart?%s", c.baseURL, params.encodeQueryParams())
+	resp, header, err := c.makeHTTPRequest(chartURL)
+	if err != nil {
+		return nil, toPlanRestrictedError(err, "/global/market_cap_chart")
+	}
+
+	data := &types.GlobalMarketCapChartResponse{
+		Data: &types.GlobalMarketCapChart{
+			BaseResult: types.NewBaseResult(header),
+		},
+	}
+	if err = json.Unmarshal(resp, &data); err != nil {
+		return nil, err
+	}
+
+	return data.Data, nil
+}
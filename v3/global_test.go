@@ -4,6 +4,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 func TestClient_Global(t *testing.T) {
@@ -24,5 +25,49 @@ func TestClient_Global(t *testing.T) {
 	assert.Equal(t, 64403004430.82966, got.TotalVolume["xrp"], "got.TotalVolume[\"xrp\"]")
 	assert.Equal(t, 2.0261520751961872, got.MarketCapPercentage["xrp"], "got.MarketCapPercentage[\"xrp\"]")
 	assert.Equal(t, -0.06802310774450489, got.MarketCapChangePercentage24hUSD, "got.MarketCapChangePercentage24hUSD")
-	assert.Equal(t, int64(1673138179), got.UpdatedAt, "got.UpdatedAt")
+	assert.Equal(t, time.Unix(1673138179, 0).UTC(), got.UpdatedAt.Time, "got.UpdatedAt")
+}
+
+func TestClient_GlobalDecentralizedFinanceDefi(t *testing.T) {
+	err := setupGock("json/global_decentralized_finance_defi.json", "json/common.headers.json", "/global/decentralized_finance_defi")
+	require.NoError(t, err)
+
+	got, err := c.GlobalDecentralizedFinanceDefi()
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+
+	assert.Equal(t, "41498226213.3908522937227766271", got.DefiMarketCap.String(), "got.DefiMarketCap")
+	assert.Equal(t, 41498226213.390854, got.DefiMarketCap.Float64(), "got.DefiMarketCap.Float64()")
+	assert.Equal(t, "160463839009.2385818373549591", got.EthMarketCap.String(), "got.EthMarketCap")
+	assert.Equal(t, "25.861886598052405316473837553232484", got.DefiToEthRatio.String(), "got.DefiToEthRatio")
+	assert.Equal(t, "2766196186.6453087226478548478", got.TradingVolume24h.String(), "got.TradingVolume24h")
+	assert.Equal(t, "4.6431254880290693622397946453413", got.DefiDominance.String(), "got.DefiDominance")
+	assert.Equal(t, "Lido Staked Ether", got.TopCoinName, "got.TopCoinName")
+	assert.Equal(t, 18.43467811962426, got.TopCoinDefiDominance, "got.TopCoinDefiDominance")
+}
+
+func TestClient_GlobalMarketCapChart(t *testing.T) {
+	err := setupProGock("json/global_market_cap_chart.json", "json/common.headers.json", "/global/market_cap_chart")
+	require.NoError(t, err)
+
+	got, err := proC.GlobalMarketCapChart(GlobalMarketCapChartParams{
+		Days:       "2",
+		VsCurrency: "usd",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+
+	require.Len(t, got.MarketCap, 2, "got.MarketCap")
+	assert.Equal(t, 796581718880.8451, got.MarketCap[0].Value, "got.MarketCap[0].Value")
+	require.Len(t, got.Volume, 2, "got.Volume")
+	assert.Equal(t, 27812033197.10213, got.Volume[1].Value, "got.Volume[1].Value")
+}
+
+func TestClient_GlobalMarketCapChart_notPro(t *testing.T) {
+	_, err := c.GlobalMarketCapChart(GlobalMarketCapChartParams{Days: "1"})
+	assert.ErrorIs(t, err, ErrProPlanRequired)
 }
@@ -0,0 +1,9 @@
+// Package internal shares unexported coingecko.Client functionality with its sibling packages, such as onchain,
+// without widening the coingecko package API.
+package internal
+
+import "net/http"
+
+// Get performs a GET request for path, relative to the base URL of client, a *coingecko.Client, using its HTTP
+// transport and API key. Set by the coingecko package.
+var Get func(client interface{}, path string) ([]byte, http.Header, error)
@@ -0,0 +1,30 @@
+package coingecko
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/edward-yakop/go-gecko/v3/types"
+)
+
+// KeyUsage /key endpoint. Paid plan only.
+func (c *Client) KeyUsage() (*types.KeyUsage, error) {
+	if err := c.requirePro("/key"); err != nil {
+		return nil, err
+	}
+
+	keyURL := fmt.Sprintf("%s/key", c.baseURL)
+
+	resp, header, err := c.makeHTTPRequest(keyURL)
+	if err != nil {
+		return nil, toPlanRestrictedError(err, "/key")
+	}
+
+	data := &types.KeyUsage{
+		BaseResult: types.NewBaseResult(header),
+	}
+	if err = json.Unmarshal(resp, &data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,43 @@
+package coingecko
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+func TestClient_KeyUsage(t *testing.T) {
+	err := setupProGock("json/key.json", "json/common.headers.json", "/key")
+	require.NoError(t, err)
+
+	got, err := proC.KeyUsage()
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+
+	assert.Equal(t, "Analyst", got.Plan, "got.Plan")
+	assert.Equal(t, 500, got.RateLimitRequestPerMinute, "got.RateLimitRequestPerMinute")
+	assert.Equal(t, int64(500000), got.MonthlyCallCredit, "got.MonthlyCallCredit")
+	assert.Equal(t, int64(104), got.CurrentTotalMonthlyCalls, "got.CurrentTotalMonthlyCalls")
+	assert.Equal(t, int64(499896), got.CurrentRemainingMonthlyCalls, "got.CurrentRemainingMonthlyCalls")
+}
+
+func TestClient_KeyUsage_notPro(t *testing.T) {
+	_, err := c.KeyUsage()
+	assert.ErrorIs(t, err, ErrProPlanRequired)
+}
+
+func TestClient_KeyUsage_invalidKey(t *testing.T) {
+	setupProGockError("/key", http.StatusUnauthorized, `{"status":{"error_code":10002,"error_message":"API Key Missing"}}`)
+
+	_, err := proC.KeyUsage()
+	assert.ErrorIs(t, err, ErrInvalidAPIKey)
+	assert.NotErrorIs(t, err, ErrProPlanRequired)
+
+	var rErr *ResponseError
+	if assert.ErrorAs(t, err, &rErr) {
+		assert.Equal(t, http.StatusUnauthorized, rErr.StatusCode, "rErr.StatusCode")
+	}
+}
@@ -0,0 +1,127 @@
+package coingecko
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/edward-yakop/go-gecko/format"
+	"github.com/edward-yakop/go-gecko/v3/types"
+	"net/url"
+)
+
+type NFTsListParams struct {
+	Order    types.NFTsListOrder `json:"order"`     // Default to NFTsListOrderNone
+	PageSize int                 `json:"page_size"` // Total results per page, between 1-250. When invalid, default to 100.
+	PageNo   int                 `json:"page_no"`   // Page through results. When < 1, default to 1.
+}
+
+func (p NFTsListParams) Valid() error {
+	if !p.Order.Valid() {
+		return fmt.Errorf("invalid Order %d", p.Order)
+	}
+
+	return nil
+}
+
+func (p NFTsListParams) encodeQueryParams() string {
+	params := url.Values{}
+
+	if p.Order != types.NFTsListOrderNone {
+		params.Add("order", p.Order.String())
+	}
+
+	if p.PageSize < 1 || p.PageSize > 250 {
+		p.PageSize = 100
+	}
+	params.Add("per_page", format.Int2String(p.PageSize))
+
+	if p.PageNo < 1 {
+		p.PageNo = 1
+	}
+	params.Add("page", format.Int2String(p.PageNo))
+
+	return params.Encode()
+}
+
+// NFTsList /nfts/list
+func (c *Client) NFTsList(params NFTsListParams) (*types.NFTsList, error) {
+	if err := params.Valid(); err != nil {
+		return nil, err
+	}
+
+	nftsListURL := fmt.Sprintf("%s/nfts/list?%s", c.baseURL, params.encodeQueryParams())
+	resp, header, err := c.makeHTTPRequest(nftsListURL)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &types.NFTsList{
+		BasePageResult: types.NewBasePageResult(header, params.PageNo),
+		NFTs:           []types.NFTsListItem{},
+	}
+	if err = json.Unmarshal(resp, &data.NFTs); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// NFTsID /nfts/{id}
+func (c *Client) NFTsID(nftID string) (*types.NFTsID, error) {
+	if nftID == "" {
+		return nil, fmt.Errorf("nftID is required")
+	}
+
+	nftsIDURL := fmt.Sprintf("%s/nfts/%s", c.baseURL, nftID)
+
+	return c.nftsID(nftsIDURL)
+}
+
+type NFTsContractAddressParams struct {
+	AssetPlatformID string `json:"asset_platform_id"` // Asset platform ID (e.g. ethereum), refers to AssetPlatforms
+	ContractAddress string `json:"contract_address"`  // NFT collection contract address
+}
+
+func (p NFTsContractAddressParams) Valid() error {
+	if p.AssetPlatformID == "" {
+		return fmt.Errorf("AssetPlatformID is required")
+	}
+
+	if p.ContractAddress == "" {
+		return fmt.Errorf("ContractAddress is required")
+	}
+
+	return nil
+}
+
+// NFTsContractAddress /nfts/{asset_platform_id}/contract/{contract_address}. Returns an error wrapping
+// ErrAssetPlatformNotFound or ErrContractNotFound when CoinGecko does not recognize the asset platform or the contract.
+// On 404 an extra AssetPlatforms request is made to tell the two apart.
+func (c *Client) NFTsContractAddress(params NFTsContractAddressParams) (*types.NFTsID, error) {
+	if err := params.Valid(); err != nil {
+		return nil, err
+	}
+
+	nftsContractURL := fmt.Sprintf("%s/nfts/%s/contract/%s", c.baseURL, url.PathEscape(params.AssetPlatformID), url.PathEscape(params.ContractAddress))
+	data, err := c.nftsID(nftsContractURL)
+	if err != nil {
+		return nil, c.toContractNotFoundError(err, params.AssetPlatformID, params.ContractAddress)
+	}
+
+	return data, nil
+}
+
+func (c *Client) nftsID(nftsIDURL string) (*types.NFTsID, error) {
+	resp, header, err := c.makeHTTPRequest(nftsIDURL)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &types.NFTsID{
+		BaseResult: types.NewBaseResult(header),
+	}
+	if err = json.Unmarshal(resp, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
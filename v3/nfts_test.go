@@ -0,0 +1,94 @@
+package coingecko
+
+import (
+	"github.com/edward-yakop/go-gecko/v3/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+func TestClient_NFTsList(t *testing.T) {
+	err := setupGock("json/nfts_list.json", "json/common_page.headers.json", "/nfts/list")
+	require.NoError(t, err)
+
+	got, err := c.NFTsList(NFTsListParams{
+		Order: types.NFTsListOrderMarketCapUsdDesc,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, commonBasePageResult, got.BasePageResult)
+
+	require.Len(t, got.NFTs, 2)
+	bayc := got.NFTs[0]
+	assert.Equal(t, "bored-ape-yacht-club", bayc.ID, "bayc.ID")
+	assert.Equal(t, "0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d", bayc.ContractAddress, "bayc.ContractAddress")
+	assert.Equal(t, "ethereum", bayc.AssetPlatformID, "bayc.AssetPlatformID")
+	assert.Equal(t, "BAYC", bayc.Symbol, "bayc.Symbol")
+}
+
+func TestClient_NFTsList_invalidOrder(t *testing.T) {
+	_, err := c.NFTsList(NFTsListParams{
+		Order: types.NFTsListOrder(99),
+	})
+	assert.Error(t, err)
+}
+
+func TestClient_NFTsID(t *testing.T) {
+	err := setupGock("json/nfts_id.json", "json/common.headers.json", "/nfts/pudgy-penguins")
+	require.NoError(t, err)
+
+	got, err := c.NFTsID("pudgy-penguins")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+	assertPudgyPenguins(t, got)
+}
+
+func TestClient_NFTsContractAddress(t *testing.T) {
+	err := setupGock("json/nfts_id.json", "json/common.headers.json", "/nfts/ethereum/contract/0xbd3531da5cf5857e7cfaa92426877b022e612cf8")
+	require.NoError(t, err)
+
+	got, err := c.NFTsContractAddress(NFTsContractAddressParams{
+		AssetPlatformID: "ethereum",
+		ContractAddress: "0xbd3531da5cf5857e7cfaa92426877b022e612cf8",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+	assertPudgyPenguins(t, got)
+}
+
+func TestClient_NFTsContractAddress_notFound(t *testing.T) {
+	setupGockError("/nfts/ethereum/contract/0xdead", http.StatusNotFound, `{"error":"coin not found"}`)
+	err := setupGock("json/asset_platforms.json", "json/common.headers.json", "/asset_platforms")
+	require.NoError(t, err)
+
+	_, err = c.NFTsContractAddress(NFTsContractAddressParams{
+		AssetPlatformID: "ethereum",
+		ContractAddress: "0xdead",
+	})
+	assert.ErrorIs(t, err, ErrContractNotFound)
+}
+
+func assertPudgyPenguins(t *testing.T, got *types.NFTsID) {
+	assert.Equal(t, "pudgy-penguins", got.ID, "got.ID")
+	assert.Equal(t, "0xbd3531da5cf5857e7cfaa92426877b022e612cf8", got.ContractAddress, "got.ContractAddress")
+	assert.Equal(t, "ETH", got.NativeCurrencySymbol, "got.NativeCurrencySymbol")
+	assert.Equal(t, 4.25, *got.FloorPrice.NativeCurrency, "got.FloorPrice.NativeCurrency")
+	assert.Equal(t, 5647.12, *got.FloorPrice.Usd, "got.FloorPrice.Usd")
+	assert.Equal(t, 50191560.0, *got.MarketCap.Usd, "got.MarketCap.Usd")
+	assert.Equal(t, 68.48, *got.Volume24h.NativeCurrency, "got.Volume24h.NativeCurrency")
+	assert.Equal(t, 1.1905, *got.FloorPrice24hPercentageChange.NativeCurrency, "got.FloorPrice24hPercentageChange.NativeCurrency")
+	assert.Equal(t, 40.11, *got.FloorPrice30dPercentageChange.Usd, "got.FloorPrice30dPercentageChange.Usd")
+	assert.Nil(t, got.FloorPrice1yPercentageChange.Usd, "got.FloorPrice1yPercentageChange.Usd")
+	assert.Equal(t, 4752, *got.NumberOfUniqueAddresses, "got.NumberOfUniqueAddresses")
+	assert.Equal(t, 8888.0, *got.TotalSupply, "got.TotalSupply")
+	assert.Equal(t, "https://twitter.com/pudgypenguins", got.Links.Twitter, "got.Links.Twitter")
+	if assert.Len(t, got.Explorers, 1, "got.Explorers") {
+		assert.Equal(t, "Etherscan", got.Explorers[0].Name, "got.Explorers[0].Name")
+	}
+}
@@ -0,0 +1,54 @@
+package onchain
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/edward-yakop/go-gecko/format"
+	"github.com/edward-yakop/go-gecko/v3/types"
+)
+
+// Networks /onchain/networks?page={page}. When page < 1, default to 1.
+func (c *Client) Networks(page int) (*Networks, error) {
+	if page < 1 {
+		page = 1
+	}
+
+	doc, header, err := c.get(fmt.Sprintf("/networks?page=%s", format.Int2String(page)))
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []resource
+	if err = json.Unmarshal(doc.Data, &resources); err != nil {
+		return nil, err
+	}
+
+	data := &Networks{
+		BaseResult: types.NewBaseResult(header),
+		Networks:   make([]NetworkItem, 0, len(resources)),
+	}
+	if doc.Links != nil {
+		data.Links = *doc.Links
+	}
+
+	for _, res := range resources {
+		network, nErr := toNetworkItem(res)
+		if nErr != nil {
+			return nil, nErr
+		}
+
+		data.Networks = append(data.Networks, *network)
+	}
+
+	return data, nil
+}
+
+func toNetworkItem(res resource) (*NetworkItem, error) {
+	network := &NetworkItem{}
+	if err := decodeAttributes(res, "network", network); err != nil {
+		return nil, err
+	}
+	network.ID = res.ID
+
+	return network, nil
+}
@@ -0,0 +1,56 @@
+package onchain
+
+import (
+	"github.com/h2non/gock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestClient_Networks(t *testing.T) {
+	req, err := setupGock("json/networks.json", "json/common.headers.json", "/networks")
+	require.NoError(t, err)
+	req.MatchParam("page", "^1$")
+
+	got, err := c.Networks(0)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.True(t, gock.IsDone(), "gock.IsDone")
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+
+	require.Len(t, got.Networks, 2)
+	assert.Equal(t, NetworkItem{
+		ID:                       "eth",
+		Name:                     "Ethereum",
+		CoingeckoAssetPlatformID: "ethereum",
+	}, got.Networks[0])
+
+	assert.Equal(t, Links{
+		First: "https://api.geckoterminal.com/api/v2/networks?page=1",
+		Next:  "https://api.geckoterminal.com/api/v2/networks?page=2",
+		Last:  "https://api.geckoterminal.com/api/v2/networks?page=8",
+	}, got.Links)
+}
+
+func TestClient_Networks_pro(t *testing.T) {
+	gock.New(proMockURL).
+		Get("/networks").
+		MatchHeader("x-cg-pro-api-key", "pro-api-key").
+		Reply(200).
+		File("json/networks.json")
+
+	got, err := proC.Networks(2)
+	require.NoError(t, err)
+	assert.Len(t, got.Networks, 2)
+}
+
+func TestClient_Networks_error(t *testing.T) {
+	gock.New(mockURL).
+		Get("/networks").
+		Reply(404).
+		BodyString(`{"errors":[{"status":"404","title":"Not Found"}]}`)
+
+	_, err := c.Networks(1)
+	assert.Error(t, err)
+}
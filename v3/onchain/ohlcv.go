@@ -0,0 +1,186 @@
+package onchain
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/edward-yakop/go-gecko/format"
+	"github.com/edward-yakop/go-gecko/v3/types"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// OHLCVTimeframe of the NetworksPoolsAddressOHLCV candles
+type OHLCVTimeframe int
+
+const (
+	OHLCVTimeframeDay OHLCVTimeframe = iota
+	OHLCVTimeframeHour
+	OHLCVTimeframeMinute
+)
+
+var ohlcvTimeframes = []string{
+	"day",
+	"hour",
+	"minute",
+}
+
+// ohlcvAggregates allowed aggregate values per timeframe
+var ohlcvAggregates = [][]int{
+	{1},
+	{1, 4, 12},
+	{1, 5, 15},
+}
+
+func (ot OHLCVTimeframe) Valid() bool {
+	return ot >= 0 && int(ot) < len(ohlcvTimeframes)
+}
+
+func (ot OHLCVTimeframe) String() string {
+	return ohlcvTimeframes[ot]
+}
+
+// OHLCVCurrency of the NetworksPoolsAddressOHLCV prices
+type OHLCVCurrency int
+
+const (
+	OHLCVCurrencyUSD OHLCVCurrency = iota
+	OHLCVCurrencyToken
+)
+
+var ohlcvCurrencies = []string{
+	"usd",
+	"token",
+}
+
+func (oc OHLCVCurrency) Valid() bool {
+	return oc >= 0 && int(oc) < len(ohlcvCurrencies)
+}
+
+func (oc OHLCVCurrency) String() string {
+	return ohlcvCurrencies[oc]
+}
+
+type NetworksPoolsAddressOHLCVParams struct {
+	Network               string         `json:"network"`                 // Network id (can be obtained from /onchain/networks), e.g. eth
+	Address               string         `json:"address"`                 // Pool contract address
+	Timeframe             OHLCVTimeframe `json:"timeframe"`               // Default to OHLCVTimeframeDay
+	Aggregate             int            `json:"aggregate"`               // Candle period in timeframe unit. day: 1, hour: 1, 4, 12, minute: 1, 5, 15. When < 1, default to 1.
+	BeforeTimestamp       time.Time      `json:"before_timestamp"`        // Return candles before this time. When zero, up to now.
+	Limit                 int            `json:"limit"`                   // Number of candles, between 1-1000. When < 1, default to 100.
+	Currency              OHLCVCurrency  `json:"currency"`                // Default to OHLCVCurrencyUSD
+	Token                 string         `json:"token"`                   // base, quote or a token address. When empty, default to base.
+	IncludeEmptyIntervals bool           `json:"include_empty_intervals"` // Sets to true to return candles for intervals without trades
+}
+
+func (p NetworksPoolsAddressOHLCVParams) Valid() error {
+	if p.Network == "" {
+		return fmt.Errorf("network is required")
+	}
+
+	if p.Address == "" {
+		return fmt.Errorf("address is required")
+	}
+
+	if !p.Timeframe.Valid() {
+		return fmt.Errorf("invalid Timeframe %d", p.Timeframe)
+	}
+
+	if p.Aggregate > 1 {
+		valid := false
+		for _, aggregate := range ohlcvAggregates[p.Timeframe] {
+			valid = valid || aggregate == p.Aggregate
+		}
+		if !valid {
+			return fmt.Errorf("invalid Aggregate %d for %s timeframe", p.Aggregate, p.Timeframe)
+		}
+	}
+
+	if p.Limit > 1000 {
+		return fmt.Errorf("invalid Limit %d, maximum is 1000", p.Limit)
+	}
+
+	if !p.Currency.Valid() {
+		return fmt.Errorf("invalid Currency %d", p.Currency)
+	}
+
+	return nil
+}
+
+func (p NetworksPoolsAddressOHLCVParams) encodeQueryParams() string {
+	params := url.Values{}
+
+	if p.Aggregate > 1 {
+		params.Add("aggregate", format.Int2String(p.Aggregate))
+	}
+
+	if !p.BeforeTimestamp.IsZero() {
+		params.Add("before_timestamp", strconv.FormatInt(p.BeforeTimestamp.Unix(), 10))
+	}
+
+	if p.Limit > 0 {
+		params.Add("limit", format.Int2String(p.Limit))
+	}
+
+	if p.Currency != OHLCVCurrencyUSD {
+		params.Add("currency", p.Currency.String())
+	}
+
+	if p.Token != "" {
+		params.Add("token", p.Token)
+	}
+
+	if p.IncludeEmptyIntervals {
+		params.Add("include_empty_intervals", "true")
+	}
+
+	return params.Encode()
+}
+
+// NetworksPoolsAddressOHLCV /onchain/networks/{network}/pools/{address}/ohlcv/{timeframe}
+func (c *Client) NetworksPoolsAddressOHLCV(params NetworksPoolsAddressOHLCVParams) (*NetworksPoolsAddressOHLCV, error) {
+	if err := params.Valid(); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/networks/%s/pools/%s/ohlcv/%s", params.Network, params.Address, params.Timeframe)
+	if query := params.encodeQueryParams(); query != "" {
+		path += "?" + query
+	}
+
+	doc, header, err := c.get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var res resource
+	if err = json.Unmarshal(doc.Data, &res); err != nil {
+		return nil, err
+	}
+
+	var attributes struct {
+		OHLCVList []OHLCVItem `json:"ohlcv_list"`
+	}
+	if err = decodeAttributes(res, "", &attributes); err != nil {
+		return nil, err
+	}
+
+	data := &NetworksPoolsAddressOHLCV{
+		BaseResult: types.NewBaseResult(header),
+		OHLCV:      attributes.OHLCVList,
+	}
+
+	if len(doc.Meta) > 0 {
+		var meta struct {
+			Base  OHLCVTokenItem `json:"base"`
+			Quote OHLCVTokenItem `json:"quote"`
+		}
+		if err = json.Unmarshal(doc.Meta, &meta); err != nil {
+			return nil, err
+		}
+		data.Base = meta.Base
+		data.Quote = meta.Quote
+	}
+
+	return data, nil
+}
@@ -0,0 +1,85 @@
+package onchain
+
+import (
+	"github.com/h2non/gock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestClient_NetworksPoolsAddressOHLCV(t *testing.T) {
+	req, err := setupGock("json/networks_pools_address_ohlcv.json", "json/common.headers.json",
+		"/networks/eth/pools/0x88e6a0c2ddd26feeb64f039a2c41296fcb3f5640/ohlcv/hour")
+	require.NoError(t, err)
+	req.
+		MatchParam("aggregate", "^4$").
+		MatchParam("before_timestamp", "^1712620800$").
+		MatchParam("limit", "^2$").
+		MatchParam("currency", "^token$")
+
+	got, err := c.NetworksPoolsAddressOHLCV(NetworksPoolsAddressOHLCVParams{
+		Network:         "eth",
+		Address:         "0x88e6a0c2ddd26feeb64f039a2c41296fcb3f5640",
+		Timeframe:       OHLCVTimeframeHour,
+		Aggregate:       4,
+		BeforeTimestamp: time.Date(2024, time.April, 9, 0, 0, 0, 0, time.UTC),
+		Limit:           2,
+		Currency:        OHLCVCurrencyToken,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.True(t, gock.IsDone(), "gock.IsDone")
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+
+	require.Len(t, got.OHLCV, 2)
+	assert.Equal(t, OHLCVItem{
+		Time:   time.Date(2024, time.April, 8, 0, 0, 0, 0, time.UTC),
+		Open:   3454.61590249189,
+		High:   3660.85954963415,
+		Low:    3417.91885296256,
+		Close:  3660.85954963415,
+		Volume: 306823.277031161,
+	}, got.OHLCV[0])
+
+	assert.Equal(t, "WETH", got.Base.Symbol, "got.Base.Symbol")
+	assert.Equal(t, "USDC", got.Quote.Symbol, "got.Quote.Symbol")
+}
+
+func TestNetworksPoolsAddressOHLCVParams_Valid(t *testing.T) {
+	valid := NetworksPoolsAddressOHLCVParams{Network: "eth", Address: "0x1"}
+	assert.NoError(t, valid.Valid())
+
+	minute15 := valid
+	minute15.Timeframe, minute15.Aggregate = OHLCVTimeframeMinute, 15
+	assert.NoError(t, minute15.Valid(), "minute 15")
+
+	noNetwork := valid
+	noNetwork.Network = ""
+	assert.Error(t, noNetwork.Valid(), "missing network")
+
+	noAddress := valid
+	noAddress.Address = ""
+	assert.Error(t, noAddress.Valid(), "missing address")
+
+	invalidTimeframe := valid
+	invalidTimeframe.Timeframe = OHLCVTimeframe(3)
+	assert.Error(t, invalidTimeframe.Valid(), "timeframe")
+
+	day4 := valid
+	day4.Aggregate = 4
+	assert.Error(t, day4.Valid(), "day 4")
+
+	hour5 := valid
+	hour5.Timeframe, hour5.Aggregate = OHLCVTimeframeHour, 5
+	assert.Error(t, hour5.Valid(), "hour 5")
+
+	invalidLimit := valid
+	invalidLimit.Limit = 1001
+	assert.Error(t, invalidLimit.Valid(), "limit")
+
+	invalidCurrency := valid
+	invalidCurrency.Currency = OHLCVCurrency(2)
+	assert.Error(t, invalidCurrency.Valid(), "currency")
+}
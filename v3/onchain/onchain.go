@@ -0,0 +1,150 @@
+// Package onchain is a client for CoinGecko on-chain DEX API (GeckoTerminal), served under /onchain.
+package onchain
+
+import (
+	"encoding/json"
+	"fmt"
+	coingecko "github.com/edward-yakop/go-gecko/v3"
+	"github.com/edward-yakop/go-gecko/v3/internal"
+	"net/http"
+)
+
+// Client on-chain DEX API client. It shares the transport and API key of the wrapped coingecko.Client.
+type Client struct {
+	client *coingecko.Client
+}
+
+// NewClient create new on-chain client object on top of the given coingecko client
+func NewClient(client *coingecko.Client) *Client {
+	return &Client{
+		client: client,
+	}
+}
+
+// Links JSON:API pagination links. Empty when not provided.
+type Links struct {
+	First string `json:"first"`
+	Prev  string `json:"prev"`
+	Next  string `json:"next"`
+	Last  string `json:"last"`
+}
+
+// document JSON:API top level envelope
+type document struct {
+	Data     json.RawMessage `json:"data"`
+	Included []resource      `json:"included"`
+	Links    *Links          `json:"links"`
+	Meta     json.RawMessage `json:"meta"`
+}
+
+// resource JSON:API resource object
+type resource struct {
+	ID            string                  `json:"id"`
+	Type          string                  `json:"type"`
+	Attributes    json.RawMessage         `json:"attributes"`
+	Relationships map[string]relationship `json:"relationships"`
+}
+
+type resourceIdentifier struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// relationship JSON:API relationship object. Data is either null, a resource identifier or an array of them.
+type relationship struct {
+	Data json.RawMessage `json:"data"`
+}
+
+func (r relationship) identifiers() ([]resourceIdentifier, error) {
+	if len(r.Data) == 0 || string(r.Data) == "null" {
+		return nil, nil
+	}
+
+	if r.Data[0] == '[' {
+		var ids []resourceIdentifier
+		if err := json.Unmarshal(r.Data, &ids); err != nil {
+			return nil, err
+		}
+
+		return ids, nil
+	}
+
+	var id resourceIdentifier
+	if err := json.Unmarshal(r.Data, &id); err != nil {
+		return nil, err
+	}
+
+	return []resourceIdentifier{id}, nil
+}
+
+// relationshipID returns the id of a to-one relationship, or empty string when absent
+func (r resource) relationshipID(name string) (resourceIdentifier, error) {
+	rel, ok := r.Relationships[name]
+	if !ok {
+		return resourceIdentifier{}, nil
+	}
+
+	ids, err := rel.identifiers()
+	if err != nil || len(ids) == 0 {
+		return resourceIdentifier{}, err
+	}
+
+	return ids[0], nil
+}
+
+// included indexes the JSON:API included resources by type and id
+type included map[resourceIdentifier]resource
+
+func newIncluded(resources []resource) included {
+	r := make(included, len(resources))
+	for _, res := range resources {
+		r[resourceIdentifier{ID: res.ID, Type: res.Type}] = res
+	}
+
+	return r
+}
+
+func (in included) find(id resourceIdentifier) (resource, bool) {
+	if id.ID == "" {
+		return resource{}, false
+	}
+
+	res, ok := in[id]
+
+	return res, ok
+}
+
+// get requests path and decodes the JSON:API envelope
+func (c *Client) get(path string) (*document, http.Header, error) {
+	resp, header, err := internal.Get(c.client, "/onchain"+path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	doc := &document{}
+	if err = json.Unmarshal(resp, doc); err != nil {
+		return nil, nil, err
+	}
+
+	return doc, header, nil
+}
+
+func decodeAttributes(res resource, expectedType string, v interface{}) error {
+	if expectedType != "" && res.Type != expectedType {
+		return fmt.Errorf("unexpected resource type %q, expecting %q", res.Type, expectedType)
+	}
+
+	if len(res.Attributes) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(res.Attributes, v)
+}
+
+func firstError(fst, snd error) error {
+	if fst != nil {
+		return fst
+	}
+
+	return snd
+}
@@ -0,0 +1,54 @@
+package onchain
+
+import (
+	"encoding/json"
+	"fmt"
+	coingecko "github.com/edward-yakop/go-gecko/v3"
+	"github.com/edward-yakop/go-gecko/v3/types"
+	"github.com/h2non/gock"
+	"net/http"
+	"os"
+	"time"
+)
+
+func init() {
+	defer gock.Off()
+}
+
+var c = NewClient(coingecko.NewClient(nil))
+var mockURL = "https://api.coingecko.com/api/v3/onchain"
+
+var proC = NewClient(coingecko.NewClient(nil, coingecko.WithAPIKey("pro-api-key")))
+var proMockURL = "https://pro-api.coingecko.com/api/v3/onchain"
+
+// Util: Setup Gock
+func setupGock(bodyFileName, headerFileName, url string) (*gock.Request, error) {
+	bodyBA, err := os.ReadFile(bodyFileName)
+	if err != nil {
+		return nil, fmt.Errorf("fail to read %s: %v", bodyFileName, err)
+	}
+
+	req := gock.New(mockURL).
+		Get(url)
+
+	g := req.
+		Reply(http.StatusOK).
+		JSON(bodyBA)
+
+	if headerFileName != "" {
+		if headerBA, hErr := os.ReadFile(headerFileName); hErr == nil {
+			if jErr := json.Unmarshal(headerBA, &g.Header); jErr != nil {
+				return nil, fmt.Errorf("fail to unmarshal json [%s]: %v", headerFileName, jErr)
+			}
+		} else {
+			return nil, fmt.Errorf("fail to read header [%s] file: %v", headerFileName, hErr)
+		}
+	}
+
+	return req, nil
+}
+
+var commonBaseResult = types.BaseResult{
+	CacheMaxAge:  time.Second * 120,
+	CacheExpires: time.Date(2023, time.January, 11, 12, 44, 47, 0, time.UTC),
+}
@@ -0,0 +1,248 @@
+package onchain
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/edward-yakop/go-gecko/format"
+	"github.com/edward-yakop/go-gecko/v3/types"
+	"net/url"
+	"strings"
+)
+
+// PoolInclude related resource to resolve with pools
+type PoolInclude int
+
+const (
+	PoolIncludeBaseToken PoolInclude = iota
+	PoolIncludeQuoteToken
+	PoolIncludeDex
+	PoolIncludeNetwork
+)
+
+var poolIncludes = []string{
+	"base_token",
+	"quote_token",
+	"dex",
+	"network",
+}
+
+func (pi PoolInclude) Valid() bool {
+	return pi >= 0 && int(pi) < len(poolIncludes)
+}
+
+func (pi PoolInclude) String() string {
+	return poolIncludes[pi]
+}
+
+func validateIncludes(includes []PoolInclude) error {
+	for _, include := range includes {
+		if !include.Valid() {
+			return fmt.Errorf("invalid include %d", include)
+		}
+	}
+
+	return nil
+}
+
+func encodeIncludes(params url.Values, includes []PoolInclude) {
+	if len(includes) == 0 {
+		return
+	}
+
+	values := make([]string, len(includes))
+	for i, include := range includes {
+		values[i] = include.String()
+	}
+	params.Add("include", strings.Join(values, ","))
+}
+
+type NetworksPoolsAddressParams struct {
+	Network string        `json:"network"` // Network id (can be obtained from /onchain/networks), e.g. eth
+	Address string        `json:"address"` // Pool contract address
+	Include []PoolInclude `json:"include"` // Related resources to resolve
+}
+
+func (p NetworksPoolsAddressParams) Valid() error {
+	if p.Network == "" {
+		return fmt.Errorf("network is required")
+	}
+
+	if p.Address == "" {
+		return fmt.Errorf("address is required")
+	}
+
+	return validateIncludes(p.Include)
+}
+
+func (p NetworksPoolsAddressParams) encodeQueryParams() string {
+	params := url.Values{}
+
+	encodeIncludes(params, p.Include)
+
+	return params.Encode()
+}
+
+// NetworksPoolsAddress /onchain/networks/{network}/pools/{address}
+func (c *Client) NetworksPoolsAddress(params NetworksPoolsAddressParams) (*NetworksPoolsAddress, error) {
+	if err := params.Valid(); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/networks/%s/pools/%s", params.Network, params.Address)
+	if query := params.encodeQueryParams(); query != "" {
+		path += "?" + query
+	}
+
+	doc, header, err := c.get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var res resource
+	if err = json.Unmarshal(doc.Data, &res); err != nil {
+		return nil, err
+	}
+
+	pool, err := toPoolItem(res, newIncluded(doc.Included))
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworksPoolsAddress{
+		BaseResult: types.NewBaseResult(header),
+		Pool:       *pool,
+	}, nil
+}
+
+type PoolsParams struct {
+	Network string        `json:"network"` // Network id (can be obtained from /onchain/networks). When empty, across all networks.
+	Include []PoolInclude `json:"include"` // Related resources to resolve
+	PageNo  int           `json:"page_no"` // Page through results. When < 1, default to 1.
+}
+
+func (p PoolsParams) Valid() error {
+	return validateIncludes(p.Include)
+}
+
+func (p PoolsParams) encodeQueryParams() string {
+	params := url.Values{}
+
+	encodeIncludes(params, p.Include)
+
+	if p.PageNo < 1 {
+		p.PageNo = 1
+	}
+	params.Add("page", format.Int2String(p.PageNo))
+
+	return params.Encode()
+}
+
+func (p PoolsParams) path(list string) string {
+	if p.Network == "" {
+		return fmt.Sprintf("/networks/%s?%s", list, p.encodeQueryParams())
+	}
+
+	return fmt.Sprintf("/networks/%s/%s?%s", p.Network, list, p.encodeQueryParams())
+}
+
+// NetworksTrendingPools /onchain/networks/trending_pools or /onchain/networks/{network}/trending_pools when
+// PoolsParams.Network is set
+func (c *Client) NetworksTrendingPools(params PoolsParams) (*Pools, error) {
+	if err := params.Valid(); err != nil {
+		return nil, err
+	}
+
+	return c.pools(params.path("trending_pools"))
+}
+
+// NetworksNewPools /onchain/networks/new_pools or /onchain/networks/{network}/new_pools when PoolsParams.Network is
+// set
+func (c *Client) NetworksNewPools(params PoolsParams) (*Pools, error) {
+	if err := params.Valid(); err != nil {
+		return nil, err
+	}
+
+	return c.pools(params.path("new_pools"))
+}
+
+func (c *Client) pools(path string) (*Pools, error) {
+	doc, header, err := c.get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []resource
+	if err = json.Unmarshal(doc.Data, &resources); err != nil {
+		return nil, err
+	}
+
+	data := &Pools{
+		BaseResult: types.NewBaseResult(header),
+		Pools:      make([]PoolItem, 0, len(resources)),
+	}
+	if doc.Links != nil {
+		data.Links = *doc.Links
+	}
+
+	in := newIncluded(doc.Included)
+	for _, res := range resources {
+		pool, pErr := toPoolItem(res, in)
+		if pErr != nil {
+			return nil, pErr
+		}
+
+		data.Pools = append(data.Pools, *pool)
+	}
+
+	return data, nil
+}
+
+func toPoolItem(res resource, in included) (*PoolItem, error) {
+	pool := &PoolItem{}
+	if err := decodeAttributes(res, "pool", pool); err != nil {
+		return nil, err
+	}
+	pool.ID = res.ID
+
+	baseToken, bErr := res.relationshipID("base_token")
+	quoteToken, qErr := res.relationshipID("quote_token")
+	dex, dErr := res.relationshipID("dex")
+	network, nErr := res.relationshipID("network")
+	if err := firstError(firstError(bErr, qErr), firstError(dErr, nErr)); err != nil {
+		return nil, err
+	}
+
+	pool.BaseTokenID = baseToken.ID
+	pool.QuoteTokenID = quoteToken.ID
+	pool.DexID = dex.ID
+	pool.NetworkID = network.ID
+
+	var err error
+	if r, ok := in.find(baseToken); ok {
+		pool.BaseToken, err = toTokenItem(r)
+	}
+	if r, ok := in.find(quoteToken); ok && err == nil {
+		pool.QuoteToken, err = toTokenItem(r)
+	}
+	if r, ok := in.find(dex); ok && err == nil {
+		pool.Dex, err = toDexItem(r)
+	}
+	if r, ok := in.find(network); ok && err == nil {
+		pool.Network, err = toNetworkItem(r)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+func toDexItem(res resource) (*DexItem, error) {
+	dex := &DexItem{}
+	if err := decodeAttributes(res, "dex", dex); err != nil {
+		return nil, err
+	}
+	dex.ID = res.ID
+
+	return dex, nil
+}
@@ -0,0 +1,98 @@
+package onchain
+
+import (
+	"github.com/h2non/gock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestClient_NetworksPoolsAddress(t *testing.T) {
+	req, err := setupGock("json/networks_pools_address.json", "json/common.headers.json",
+		"/networks/eth/pools/0x88e6a0c2ddd26feeb64f039a2c41296fcb3f5640")
+	require.NoError(t, err)
+	req.MatchParam("include", "^base_token,dex$")
+
+	got, err := c.NetworksPoolsAddress(NetworksPoolsAddressParams{
+		Network: "eth",
+		Address: "0x88e6a0c2ddd26feeb64f039a2c41296fcb3f5640",
+		Include: []PoolInclude{PoolIncludeBaseToken, PoolIncludeDex},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.True(t, gock.IsDone(), "gock.IsDone")
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+
+	pool := got.Pool
+	assert.Equal(t, "eth_0x88e6a0c2ddd26feeb64f039a2c41296fcb3f5640", pool.ID, "pool.ID")
+	assert.Equal(t, "WETH / USDC 0.05%", pool.Name, "pool.Name")
+	assert.Equal(t, time.Date(2021, time.December, 29, 12, 35, 14, 0, time.UTC), pool.PoolCreatedAt, "pool.PoolCreatedAt")
+	require.NotNil(t, pool.BaseTokenPriceUSD, "pool.BaseTokenPriceUSD")
+	assert.Equal(t, 3653.12491645176, *pool.BaseTokenPriceUSD, "pool.BaseTokenPriceUSD")
+	assert.Nil(t, pool.MarketCapUSD, "pool.MarketCapUSD")
+	assert.Equal(t, 7.71, pool.PriceChangePercentage["h24"], "pool.PriceChangePercentage[h24]")
+	assert.Equal(t, PoolTransactionsItem{Buys: 7, Sells: 5, Buyers: 7, Sellers: 5}, pool.Transactions["m5"], "pool.Transactions[m5]")
+	assert.Equal(t, 536545444.904535, pool.VolumeUSD["h24"], "pool.VolumeUSD[h24]")
+
+	// Relationships
+	assert.Equal(t, "eth_0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2", pool.BaseTokenID, "pool.BaseTokenID")
+	assert.Equal(t, "eth_0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", pool.QuoteTokenID, "pool.QuoteTokenID")
+	assert.Equal(t, "uniswap_v3", pool.DexID, "pool.DexID")
+	assert.Empty(t, pool.NetworkID, "pool.NetworkID")
+
+	require.NotNil(t, pool.BaseToken, "pool.BaseToken")
+	assert.Equal(t, "WETH", pool.BaseToken.Symbol, "pool.BaseToken.Symbol")
+	assert.Equal(t, 18, pool.BaseToken.Decimals, "pool.BaseToken.Decimals")
+	assert.Nil(t, pool.QuoteToken, "pool.QuoteToken is not included")
+	assert.Equal(t, &DexItem{ID: "uniswap_v3", Name: "Uniswap V3"}, pool.Dex, "pool.Dex")
+	assert.Nil(t, pool.Network, "pool.Network")
+}
+
+func TestNetworksPoolsAddressParams_Valid(t *testing.T) {
+	assert.Error(t, NetworksPoolsAddressParams{Address: "0x1"}.Valid(), "missing Network")
+	assert.Error(t, NetworksPoolsAddressParams{Network: "eth"}.Valid(), "missing Address")
+	assert.Error(t, NetworksPoolsAddressParams{Network: "eth", Address: "0x1", Include: []PoolInclude{PoolInclude(-1)}}.Valid(), "invalid Include")
+	assert.NoError(t, NetworksPoolsAddressParams{Network: "eth", Address: "0x1", Include: []PoolInclude{PoolIncludeNetwork}}.Valid())
+}
+
+func TestClient_NetworksTrendingPools(t *testing.T) {
+	req, err := setupGock("json/networks_trending_pools.json", "json/common.headers.json", "/networks/trending_pools")
+	require.NoError(t, err)
+	req.MatchParam("include", "^network$").MatchParam("page", "^2$")
+
+	got, err := c.NetworksTrendingPools(PoolsParams{
+		Include: []PoolInclude{PoolIncludeNetwork},
+		PageNo:  2,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.True(t, gock.IsDone(), "gock.IsDone")
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+	assert.Equal(t, Links{}, got.Links, "got.Links")
+
+	require.Len(t, got.Pools, 2)
+	assert.Equal(t, "BONK / SOL", got.Pools[0].Name, "got.Pools[0].Name")
+	require.NotNil(t, got.Pools[0].MarketCapUSD, "got.Pools[0].MarketCapUSD")
+	assert.Equal(t, 1642101000.27, *got.Pools[0].MarketCapUSD, "got.Pools[0].MarketCapUSD")
+	assert.Equal(t, &NetworkItem{ID: "solana", Name: "Solana", CoingeckoAssetPlatformID: "solana"}, got.Pools[0].Network, "got.Pools[0].Network")
+	assert.Equal(t, &NetworkItem{ID: "eth", Name: "Ethereum", CoingeckoAssetPlatformID: "ethereum"}, got.Pools[1].Network, "got.Pools[1].Network")
+	assert.Nil(t, got.Pools[1].BaseToken, "got.Pools[1].BaseToken")
+}
+
+func TestClient_NetworksNewPools(t *testing.T) {
+	req, err := setupGock("json/networks_trending_pools.json", "json/common.headers.json", "/networks/eth/new_pools")
+	require.NoError(t, err)
+	req.MatchParam("page", "^1$")
+
+	got, err := c.NetworksNewPools(PoolsParams{
+		Network: "eth",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.True(t, gock.IsDone(), "gock.IsDone")
+
+	assert.Len(t, got.Pools, 2)
+}
@@ -0,0 +1,48 @@
+package onchain
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/edward-yakop/go-gecko/v3/types"
+)
+
+// NetworksTokensAddressInfo /onchain/networks/{network}/tokens/{address}/info
+func (c *Client) NetworksTokensAddressInfo(network, address string) (*NetworksTokensAddressInfo, error) {
+	if network == "" {
+		return nil, fmt.Errorf("network is required")
+	}
+
+	if address == "" {
+		return nil, fmt.Errorf("address is required")
+	}
+
+	doc, header, err := c.get(fmt.Sprintf("/networks/%s/tokens/%s/info", network, address))
+	if err != nil {
+		return nil, err
+	}
+
+	var res resource
+	if err = json.Unmarshal(doc.Data, &res); err != nil {
+		return nil, err
+	}
+
+	data := &NetworksTokensAddressInfo{
+		BaseResult: types.NewBaseResult(header),
+	}
+	if err = decodeAttributes(res, "token", &data.Token); err != nil {
+		return nil, err
+	}
+	data.Token.ID = res.ID
+
+	return data, nil
+}
+
+func toTokenItem(res resource) (*TokenItem, error) {
+	token := &TokenItem{}
+	if err := decodeAttributes(res, "token", token); err != nil {
+		return nil, err
+	}
+	token.ID = res.ID
+
+	return token, nil
+}
@@ -0,0 +1,40 @@
+package onchain
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestClient_NetworksTokensAddressInfo(t *testing.T) {
+	_, err := setupGock("json/networks_tokens_address_info.json", "json/common.headers.json",
+		"/networks/eth/tokens/0xdac17f958d2ee523a2206206994597c13d831ec7/info")
+	require.NoError(t, err)
+
+	got, err := c.NetworksTokensAddressInfo("eth", "0xdac17f958d2ee523a2206206994597c13d831ec7")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+
+	token := got.Token
+	assert.Equal(t, "eth_0xdac17f958d2ee523a2206206994597c13d831ec7", token.ID, "token.ID")
+	assert.Equal(t, "USDT", token.Symbol, "token.Symbol")
+	require.NotNil(t, token.CoingeckoCoinID, "token.CoingeckoCoinID")
+	assert.Equal(t, "tether", *token.CoingeckoCoinID, "token.CoingeckoCoinID")
+	assert.Equal(t, []string{"https://tether.to/"}, token.Websites, "token.Websites")
+	require.NotNil(t, token.GTScore, "token.GTScore")
+	assert.Equal(t, 92.66055045871559, *token.GTScore, "token.GTScore")
+	assert.Nil(t, token.DiscordURL, "token.DiscordURL")
+	require.NotNil(t, token.TwitterHandle, "token.TwitterHandle")
+	assert.Equal(t, "Tether_to", *token.TwitterHandle, "token.TwitterHandle")
+	assert.Equal(t, []string{"Stablecoin"}, token.Categories, "token.Categories")
+}
+
+func TestClient_NetworksTokensAddressInfo_invalid(t *testing.T) {
+	_, err := c.NetworksTokensAddressInfo("", "0x1")
+	assert.Error(t, err)
+
+	_, err = c.NetworksTokensAddressInfo("eth", "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,245 @@
+package onchain
+
+import (
+	"encoding/json"
+	"github.com/edward-yakop/go-gecko/v3/types"
+	"time"
+)
+
+// Networks https://api.coingecko.com/api/v3/onchain/networks
+type Networks struct {
+	types.BaseResult
+	Networks []NetworkItem
+	Links    Links
+}
+
+// NetworksPoolsAddress https://api.coingecko.com/api/v3/onchain/networks/{network}/pools/{address}
+type NetworksPoolsAddress struct {
+	types.BaseResult
+	Pool PoolItem
+}
+
+// Pools https://api.coingecko.com/api/v3/onchain/networks/trending_pools and
+// https://api.coingecko.com/api/v3/onchain/networks/new_pools
+type Pools struct {
+	types.BaseResult
+	Pools []PoolItem
+	Links Links
+}
+
+// NetworksTokensAddressInfo https://api.coingecko.com/api/v3/onchain/networks/{network}/tokens/{address}/info
+type NetworksTokensAddressInfo struct {
+	types.BaseResult
+	Token TokenInfoItem
+}
+
+// NetworksPoolsAddressOHLCV https://api.coingecko.com/api/v3/onchain/networks/{network}/pools/{address}/ohlcv/{timeframe}
+type NetworksPoolsAddressOHLCV struct {
+	types.BaseResult
+	OHLCV []OHLCVItem
+	Base  OHLCVTokenItem
+	Quote OHLCVTokenItem
+}
+
+// NetworkItem network resource
+type NetworkItem struct {
+	ID                       string `json:"-"`
+	Name                     string `json:"name"`
+	CoingeckoAssetPlatformID string `json:"coingecko_asset_platform_id"`
+}
+
+// DexItem dex resource
+type DexItem struct {
+	ID   string `json:"-"`
+	Name string `json:"name"`
+}
+
+// TokenItem token resource, as included in pools
+type TokenItem struct {
+	ID              string   `json:"-"`
+	Address         string   `json:"address"`
+	Name            string   `json:"name"`
+	Symbol          string   `json:"symbol"`
+	Decimals        int      `json:"decimals"`
+	ImageURL        string   `json:"image_url"`
+	CoingeckoCoinID *string  `json:"coingecko_coin_id"`
+	PriceUSD        *float64 `json:"price_usd"`
+}
+
+func (ti *TokenItem) UnmarshalJSON(data []byte) error {
+	type tokenItem TokenItem
+	aux := struct {
+		*tokenItem
+		PriceUSD *types.StringFloat64 `json:"price_usd"`
+	}{tokenItem: (*tokenItem)(ti)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	ti.PriceUSD = toFloat64Ptr(aux.PriceUSD)
+
+	return nil
+}
+
+// TokenInfoItem token info resource
+type TokenInfoItem struct {
+	ID              string   `json:"-"`
+	Address         string   `json:"address"`
+	Name            string   `json:"name"`
+	Symbol          string   `json:"symbol"`
+	ImageURL        string   `json:"image_url"`
+	CoingeckoCoinID *string  `json:"coingecko_coin_id"`
+	Websites        []string `json:"websites"`
+	Description     string   `json:"description"`
+	GTScore         *float64 `json:"gt_score"`
+	DiscordURL      *string  `json:"discord_url"`
+	TelegramHandle  *string  `json:"telegram_handle"`
+	TwitterHandle   *string  `json:"twitter_handle"`
+	Categories      []string `json:"categories"`
+}
+
+func (tii *TokenInfoItem) UnmarshalJSON(data []byte) error {
+	type tokenInfoItem TokenInfoItem
+	aux := struct {
+		*tokenInfoItem
+		GTScore *types.StringFloat64 `json:"gt_score"`
+	}{tokenInfoItem: (*tokenInfoItem)(tii)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	tii.GTScore = toFloat64Ptr(aux.GTScore)
+
+	return nil
+}
+
+// PoolTransactionsItem buys and sells count over a duration
+type PoolTransactionsItem struct {
+	Buys    int `json:"buys"`
+	Sells   int `json:"sells"`
+	Buyers  int `json:"buyers"`
+	Sellers int `json:"sellers"`
+}
+
+// PoolItem pool resource. BaseToken, QuoteToken, Dex and Network are only resolved when requested through include.
+type PoolItem struct {
+	ID                            string                          `json:"-"`
+	Address                       string                          `json:"address"`
+	Name                          string                          `json:"name"`
+	PoolCreatedAt                 time.Time                       `json:"pool_created_at"`
+	BaseTokenPriceUSD             *float64                        `json:"base_token_price_usd"`
+	BaseTokenPriceNativeCurrency  *float64                        `json:"base_token_price_native_currency"`
+	BaseTokenPriceQuoteToken      *float64                        `json:"base_token_price_quote_token"`
+	QuoteTokenPriceUSD            *float64                        `json:"quote_token_price_usd"`
+	QuoteTokenPriceNativeCurrency *float64                        `json:"quote_token_price_native_currency"`
+	QuoteTokenPriceBaseToken      *float64                        `json:"quote_token_price_base_token"`
+	FdvUSD                        *float64                        `json:"fdv_usd"`
+	MarketCapUSD                  *float64                        `json:"market_cap_usd"`
+	ReserveInUSD                  *float64                        `json:"reserve_in_usd"`
+	PriceChangePercentage         map[string]float64              `json:"price_change_percentage"` // Keyed by duration, e.g. m5, h1, h6, h24
+	Transactions                  map[string]PoolTransactionsItem `json:"transactions"`            // Keyed by duration, e.g. m5, m15, m30, h1, h24
+	VolumeUSD                     map[string]float64              `json:"volume_usd"`              // Keyed by duration, e.g. m5, h1, h6, h24
+
+	BaseTokenID  string       `json:"-"`
+	QuoteTokenID string       `json:"-"`
+	DexID        string       `json:"-"`
+	NetworkID    string       `json:"-"`
+	BaseToken    *TokenItem   `json:"-"`
+	QuoteToken   *TokenItem   `json:"-"`
+	Dex          *DexItem     `json:"-"`
+	Network      *NetworkItem `json:"-"`
+}
+
+func (pi *PoolItem) UnmarshalJSON(data []byte) error {
+	type poolItem PoolItem
+	aux := struct {
+		*poolItem
+		BaseTokenPriceUSD             *types.StringFloat64           `json:"base_token_price_usd"`
+		BaseTokenPriceNativeCurrency  *types.StringFloat64           `json:"base_token_price_native_currency"`
+		BaseTokenPriceQuoteToken      *types.StringFloat64           `json:"base_token_price_quote_token"`
+		QuoteTokenPriceUSD            *types.StringFloat64           `json:"quote_token_price_usd"`
+		QuoteTokenPriceNativeCurrency *types.StringFloat64           `json:"quote_token_price_native_currency"`
+		QuoteTokenPriceBaseToken      *types.StringFloat64           `json:"quote_token_price_base_token"`
+		FdvUSD                        *types.StringFloat64           `json:"fdv_usd"`
+		MarketCapUSD                  *types.StringFloat64           `json:"market_cap_usd"`
+		ReserveInUSD                  *types.StringFloat64           `json:"reserve_in_usd"`
+		PriceChangePercentage         map[string]types.StringFloat64 `json:"price_change_percentage"`
+		VolumeUSD                     map[string]types.StringFloat64 `json:"volume_usd"`
+	}{poolItem: (*poolItem)(pi)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	pi.BaseTokenPriceUSD = toFloat64Ptr(aux.BaseTokenPriceUSD)
+	pi.BaseTokenPriceNativeCurrency = toFloat64Ptr(aux.BaseTokenPriceNativeCurrency)
+	pi.BaseTokenPriceQuoteToken = toFloat64Ptr(aux.BaseTokenPriceQuoteToken)
+	pi.QuoteTokenPriceUSD = toFloat64Ptr(aux.QuoteTokenPriceUSD)
+	pi.QuoteTokenPriceNativeCurrency = toFloat64Ptr(aux.QuoteTokenPriceNativeCurrency)
+	pi.QuoteTokenPriceBaseToken = toFloat64Ptr(aux.QuoteTokenPriceBaseToken)
+	pi.FdvUSD = toFloat64Ptr(aux.FdvUSD)
+	pi.MarketCapUSD = toFloat64Ptr(aux.MarketCapUSD)
+	pi.ReserveInUSD = toFloat64Ptr(aux.ReserveInUSD)
+	pi.PriceChangePercentage = toFloat64Map(aux.PriceChangePercentage)
+	pi.VolumeUSD = toFloat64Map(aux.VolumeUSD)
+
+	return nil
+}
+
+// toFloat64Ptr returns nil when sf is nil
+func toFloat64Ptr(sf *types.StringFloat64) *float64 {
+	if sf == nil {
+		return nil
+	}
+
+	f := float64(*sf)
+
+	return &f
+}
+
+// toFloat64Map converts m, keeping nil as nil
+func toFloat64Map(m map[string]types.StringFloat64) map[string]float64 {
+	if m == nil {
+		return nil
+	}
+
+	r := make(map[string]float64, len(m))
+	for k, v := range m {
+		r[k] = float64(v)
+	}
+
+	return r
+}
+
+// OHLCVTokenItem token meta data of NetworksPoolsAddressOHLCV
+type OHLCVTokenItem struct {
+	Address         string  `json:"address"`
+	Name            string  `json:"name"`
+	Symbol          string  `json:"symbol"`
+	CoingeckoCoinID *string `json:"coingecko_coin_id"`
+}
+
+// OHLCVItem candle of NetworksPoolsAddressOHLCV
+type OHLCVItem struct {
+	Time   time.Time
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Volume float64
+}
+
+func (o *OHLCVItem) UnmarshalJSON(data []byte) error {
+	var content [6]types.StringFloat64
+	if err := json.Unmarshal(data, &content); err != nil {
+		return err
+	}
+
+	o.Time = time.Unix(int64(content[0]), 0).UTC()
+	o.Open = float64(content[1])
+	o.High = float64(content[2])
+	o.Low = float64(content[3])
+	o.Close = float64(content[4])
+	o.Volume = float64(content[5])
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package coingecko
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/edward-yakop/go-gecko/v3/types"
+	"net/url"
+)
+
+// Search /search?query={query}
+func (c *Client) Search(query string) (*types.Search, error) {
+	if query == "" {
+		return nil, fmt.Errorf("query is required")
+	}
+
+	params := url.Values{}
+	params.Add("query", query)
+
+	searchURL := fmt.Sprintf("%s/search?%s", c.baseURL, params.Encode())
+	resp, header, err := c.makeHTTPRequest(searchURL)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &types.Search{
+		BaseResult: types.NewBaseResult(header),
+	}
+	if err = json.Unmarshal(resp, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,48 @@
+package coingecko
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestClient_Search(t *testing.T) {
+	err := setupGock("json/search.json", "json/common.headers.json", "/search")
+	require.NoError(t, err)
+
+	got, err := c.Search("ethereum")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+
+	if assert.Len(t, got.Coins, 3, "len(got.Coins)") {
+		eth := got.Coins[0]
+		assert.Equal(t, "ethereum", eth.ID, "eth.ID")
+		assert.Equal(t, "Ethereum", eth.Name, "eth.Name")
+		assert.Equal(t, "ETH", eth.Symbol, "eth.Symbol")
+		assert.Equal(t, 2, *eth.MarketCapRank, "eth.MarketCapRank")
+		assert.Equal(t, "https://assets.coingecko.com/coins/images/279/thumb/ethereum.png", eth.Thumb, "eth.Thumb")
+		assert.Nil(t, got.Coins[2].MarketCapRank, "got.Coins[2].MarketCapRank")
+	}
+
+	if assert.Len(t, got.Exchanges, 2, "len(got.Exchanges)") {
+		assert.Equal(t, "uniswap_v3", got.Exchanges[0].ID, "got.Exchanges[0].ID")
+		assert.Equal(t, "spot", got.Exchanges[0].MarketType, "got.Exchanges[0].MarketType")
+	}
+
+	if assert.Len(t, got.Categories, 2, "len(got.Categories)") {
+		assert.Equal(t, "ethereum-ecosystem", got.Categories[0].ID, "got.Categories[0].ID")
+		assert.Equal(t, "Ethereum Ecosystem", got.Categories[0].Name, "got.Categories[0].Name")
+	}
+
+	if assert.Len(t, got.NFTs, 1, "len(got.NFTs)") {
+		assert.Equal(t, "cryptopunks", got.NFTs[0].ID, "got.NFTs[0].ID")
+		assert.Equal(t, "PUNK", got.NFTs[0].Symbol, "got.NFTs[0].Symbol")
+	}
+}
+
+func TestClient_Search_requiresQuery(t *testing.T) {
+	_, err := c.Search("")
+	assert.Error(t, err)
+}
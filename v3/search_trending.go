@@ -0,0 +1,25 @@
+package coingecko
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/edward-yakop/go-gecko/v3/types"
+)
+
+// SearchTrending /search/trending
+func (c *Client) SearchTrending() (*types.SearchTrending, error) {
+	searchTrendingURL := fmt.Sprintf("%s/search/trending", c.baseURL)
+	resp, header, err := c.makeHTTPRequest(searchTrendingURL)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &types.SearchTrending{
+		BaseResult: types.NewBaseResult(header),
+	}
+	if err = json.Unmarshal(resp, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,53 @@
+package coingecko
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestClient_SearchTrending(t *testing.T) {
+	err := setupGock("json/search_trending.json", "json/common.headers.json", "/search/trending")
+	require.NoError(t, err)
+
+	got, err := c.SearchTrending()
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+
+	if assert.Len(t, got.Coins, 2, "len(got.Coins)") {
+		pepe := got.Coins[0].Item
+		assert.Equal(t, "pepe", pepe.ID, "pepe.ID")
+		assert.Equal(t, 29850, pepe.CoinID, "pepe.CoinID")
+		assert.Equal(t, 27, *pepe.MarketCapRank, "pepe.MarketCapRank")
+		assert.Equal(t, 1.3648631098398e-10, *pepe.PriceBtc, "pepe.PriceBtc")
+		assert.Equal(t, 0, pepe.Score, "pepe.Score")
+		if assert.NotNil(t, pepe.Data, "pepe.Data") {
+			assert.Equal(t, 9.18236536484558e-06, *pepe.Data.Price, "pepe.Data.Price")
+			assert.Equal(t, 4.0146923, pepe.Data.PriceChangePercentage24h["usd"], "pepe.Data.PriceChangePercentage24h[\"usd\"]")
+			assert.Equal(t, "$3,862,727,428", pepe.Data.MarketCap, "pepe.Data.MarketCap")
+			assert.Equal(t, "https://www.coingecko.com/coins/29850/sparkline.svg", pepe.Data.Sparkline, "pepe.Data.Sparkline")
+		}
+
+		snc := got.Coins[1].Item
+		assert.Nil(t, snc.MarketCapRank, "snc.MarketCapRank")
+		assert.Nil(t, snc.PriceBtc, "snc.PriceBtc")
+		assert.Nil(t, snc.Data.Price, "snc.Data.Price")
+	}
+
+	if assert.Len(t, got.NFTs, 1, "len(got.NFTs)") {
+		nft := got.NFTs[0]
+		assert.Equal(t, "pudgy-penguins", nft.ID, "nft.ID")
+		assert.Equal(t, 12.5, *nft.FloorPriceInNativeCurrency, "nft.FloorPriceInNativeCurrency")
+		assert.Equal(t, "412.35 ETH", nft.Data.H24Volume, "nft.Data.H24Volume")
+	}
+
+	if assert.Len(t, got.Categories, 1, "len(got.Categories)") {
+		category := got.Categories[0]
+		assert.Equal(t, "meme-token", category.Slug, "category.Slug")
+		assert.Equal(t, 321, *category.CoinsCount, "category.CoinsCount")
+		assert.Equal(t, 55432178910.12, *category.Data.MarketCap, "category.Data.MarketCap")
+		assert.Equal(t, 2.34, category.Data.MarketCapChangePercentage24h["usd"], "category.Data.MarketCapChangePercentage24h[\"usd\"]")
+	}
+}
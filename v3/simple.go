@@ -31,14 +31,27 @@ func (params SimplePriceParams) Valid() error {
 		return fmt.Errorf("VsCurrencies is required and must contain at least 1 item")
 	}
 
-	if p := params.toInt(params.Precision); params.Precision != "" && params.Precision != "full" && (p < 0 || p > 18) {
+	return validatePrecision(params.Precision)
+}
+
+func validatePrecision(precision string) error {
+	if p := toInt(precision); precision != "" && precision != "full" && (p < 0 || p > 18) {
 		return fmt.Errorf("Precision must either be empty string, or \"full\", or an int [0,18]")
 	}
 
 	return nil
 }
 
-func (params SimplePriceParams) toInt(v string) int {
+func toDecimal(ba []byte) (*types.Decimal, error) {
+	d, err := types.NewDecimal(string(ba))
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func toInt(v string) int {
 	if asInt, err := strconv.Atoi(v); err == nil {
 		return asInt
 	}
@@ -115,7 +128,7 @@ func (c *Client) parseSimplePriceItem(coinID string, ba []byte) (*types.SimplePr
 		if key == "last_updated_at" {
 			timeAsInt, pErr := jsonparser.ParseInt(ba)
 			if pErr == nil {
-				r.LastUpdatedAt = time.Unix(timeAsInt, 0)
+				r.LastUpdatedAt = time.Unix(timeAsInt, 0).UTC()
 			} else {
 				pErr = fmt.Errorf("error parsing %s.last_updated_at = %s: %v", coinID, string(ba), pErr)
 			}
@@ -137,12 +150,15 @@ func (c *Client) parseSimplePriceItem(coinID string, ba []byte) (*types.SimplePr
 				switch suffix {
 				case "market_cap":
 					currItem.MarketCap = &v
+					currItem.MarketCapDecimal, pErr = toDecimal(ba)
 				case "24h_vol":
 					currItem.Volume24H = &v
+					currItem.Volume24HDecimal, pErr = toDecimal(ba)
 				case "24h_change":
 					currItem.ChangePercentage24H = &v
 				}
-			} else {
+			}
+			if pErr != nil {
 				pErr = fmt.Errorf("error parsing %s.%s = %s: %v", coinID, key, string(ba), pErr)
 			}
 
@@ -156,7 +172,9 @@ func (c *Client) parseSimplePriceItem(coinID string, ba []byte) (*types.SimplePr
 			v, pErr := jsonparser.ParseFloat(ba)
 			if pErr == nil {
 				currItem.Price = v
-			} else {
+				currItem.PriceDecimal, pErr = types.NewDecimal(string(ba))
+			}
+			if pErr != nil {
 				pErr = fmt.Errorf("error parsing %s.%s.Price = %s: %v", coinID, key, string(ba), pErr)
 			}
 
@@ -171,6 +189,92 @@ func (c *Client) parseSimplePriceItem(coinID string, ba []byte) (*types.SimplePr
 	return r, err
 }
 
+type SimpleTokenPriceParams struct {
+	AssetPlatformID   string   `json:"asset_platform_id"`    // Asset platform ID (e.g. ethereum, solana), refers to AssetPlatforms
+	ContractAddresses []string `json:"contract_addresses"`   // Token contract addresses
+	VsCurrencies      []string `json:"vs_currencies"`        // VsCurrencies refers to SimpleSupportedVSCurrencies
+	MarketCap         bool     `json:"market_cap"`           // Sets to true to include market cap. Default false.
+	Include24HrVolume bool     `json:"include_24_hr_volume"` // Sets to true to include 24 Hr volume. Default false.
+	Include24HrChange bool     `json:"include_24_hr_change"` // Sets to true to include 24 Hr change. Default false.
+	LastUpdatedAt     bool     `json:"last_updated_at"`      // Sets to true to include last updated at. Default false.
+	Precision         string   `json:"precision"`            // valid values: empty string, "full", an int [0,18]
+}
+
+func (params SimpleTokenPriceParams) Valid() error {
+	if params.AssetPlatformID == "" {
+		return fmt.Errorf("AssetPlatformID is required")
+	}
+
+	if len(params.ContractAddresses) < 1 {
+		return fmt.Errorf("ContractAddresses is required and must contain at least 1 item")
+	}
+
+	if len(params.VsCurrencies) < 1 {
+		return fmt.Errorf("VsCurrencies is required and must contain at least 1 item")
+	}
+
+	return validatePrecision(params.Precision)
+}
+
+func (params SimpleTokenPriceParams) encodeWithoutAssetPlatformID() string {
+	values := url.Values{}
+
+	values.Add("contract_addresses", strings.Join(params.ContractAddresses, ","))
+	values.Add("vs_currencies", strings.Join(params.VsCurrencies, ","))
+	if params.MarketCap {
+		values.Add("include_market_cap", format.Bool2String(params.MarketCap))
+	}
+	if params.Include24HrVolume {
+		values.Add("include_24hr_vol", format.Bool2String(params.Include24HrVolume))
+	}
+	if params.Include24HrChange {
+		values.Add("include_24hr_change", format.Bool2String(params.Include24HrChange))
+	}
+	if params.LastUpdatedAt {
+		values.Add("include_last_updated_at", format.Bool2String(params.LastUpdatedAt))
+	}
+	if params.Precision != "" {
+		values.Add("precision", params.Precision)
+	}
+
+	return values.Encode()
+}
+
+// SimpleTokenPrice /simple/token_price/{id} Multiple contract addresses and currencies (contract_addresses, vs_currencies)
+func (c *Client) SimpleTokenPrice(params SimpleTokenPriceParams) (*types.SimpleTokenPrice, error) {
+	if err := params.Valid(); err != nil {
+		return nil, err
+	}
+
+	simpleTokenPriceURL := fmt.Sprintf("%s/simple/token_price/%s?%s", c.baseURL, params.AssetPlatformID, params.encodeWithoutAssetPlatformID())
+	resp, header, err := c.makeHTTPRequest(simpleTokenPriceURL)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make(map[string]*types.SimplePriceItem)
+	r := &types.SimpleTokenPrice{
+		BaseResult: types.NewBaseResult(header),
+		Tokens:     tokens,
+	}
+
+	err = jsonparser.ObjectEach(resp, func(addressBA []byte, ba []byte, _ jsonparser.ValueType, offset int) error {
+		address := strings.ToLower(string(addressBA))
+		item, iErr := c.parseSimplePriceItem(address, ba)
+		if iErr == nil {
+			tokens[address] = item
+		}
+
+		return iErr
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
 // SimpleSupportedVSCurrencies /simple/supported_vs_currencies
 func (c *Client) SimpleSupportedVSCurrencies() (*types.SimpleSupportedVSCurrencies, error) {
 	simpleURL := fmt.Sprintf("%s/simple/supported_vs_currencies", c.baseURL)
@@ -97,3 +97,64 @@ func TestSimpleSupportedVSCurrencies(t *testing.T) {
 
 	assert.Len(t, s.CurrencyIDs, 54)
 }
+
+func TestClient_SimpleTokenPrice(t *testing.T) {
+	err := setupGock("json/simple_token_price.json", "json/common.headers.json", "/simple/token_price/ethereum")
+	require.NoError(t, err)
+
+	got, err := c.SimpleTokenPrice(SimpleTokenPriceParams{
+		AssetPlatformID:   "ethereum",
+		ContractAddresses: []string{"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", "0x1f9840a85d5af5bf1d1762f925bdaddc4201f984"},
+		VsCurrencies:      []string{"usd", "eth"},
+		MarketCap:         true,
+		Include24HrVolume: true,
+		Include24HrChange: true,
+		LastUpdatedAt:     true,
+		Precision:         "full",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, commonBaseResult, got.BaseResult)
+
+	if usdc := got.Tokens["0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"]; assert.NotNil(t, usdc, "usdc") {
+		if usd := usdc.Currencies["usd"]; assert.NotNil(t, usd, "usdc.Currencies[\"usd\"]") {
+			assert.Equal(t, 1.001, usd.Price, "usdc.usd.Price")
+			assert.Equal(t, 44421437890.35498, *usd.MarketCap, "usdc.usd.MarketCap")
+			assert.Equal(t, 2868392910.428337, *usd.Volume24H, "usdc.usd.Volume24H")
+			assert.Equal(t, 0.10146230453574, *usd.ChangePercentage24H, "usdc.usd.ChangePercentage24H")
+			assert.Equal(t, "1.001", usd.PriceDecimal.String(), "usdc.usd.PriceDecimal")
+			assert.Equal(t, "44421437890.35498", usd.MarketCapDecimal.String(), "usdc.usd.MarketCapDecimal")
+			assert.Equal(t, "2868392910.428337", usd.Volume24HDecimal.String(), "usdc.usd.Volume24HDecimal")
+		}
+		if eth := usdc.Currencies["eth"]; assert.NotNil(t, eth, "usdc.Currencies[\"eth\"]") {
+			assert.Equal(t, "0.000748170000000001234", eth.PriceDecimal.String(), "usdc.eth.PriceDecimal keeps full precision")
+			assert.Equal(t, eth.Price, eth.PriceDecimal.Float64(), "usdc.eth.PriceDecimal.Float64")
+		}
+		assert.Equal(t, time.Date(2023, time.January, 11, 8, 44, 49, 0, time.UTC), usdc.LastUpdatedAt.UTC())
+	}
+
+	if uni := got.Tokens["0x1f9840a85d5af5bf1d1762f925bdaddc4201f984"]; assert.NotNil(t, uni, "uni is keyed by lower-cased address") {
+		if eth := uni.Currencies["eth"]; assert.NotNil(t, eth, "uni.Currencies[\"eth\"]") {
+			assert.Equal(t, 0.00415773, eth.Price, "uni.eth.Price")
+			assert.Equal(t, -0.1939204521351, *eth.ChangePercentage24H, "uni.eth.ChangePercentage24H")
+		}
+	}
+}
+
+func TestSimpleTokenPriceParams_Valid(t *testing.T) {
+	valid := SimpleTokenPriceParams{
+		AssetPlatformID:   "ethereum",
+		ContractAddresses: []string{"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"},
+		VsCurrencies:      []string{"usd"},
+	}
+	assert.NoError(t, valid.Valid())
+
+	noPlatform := valid
+	noPlatform.AssetPlatformID = ""
+	assert.Error(t, noPlatform.Valid())
+
+	invalidPrecision := valid
+	invalidPrecision.Precision = "19"
+	assert.Error(t, invalidPrecision.Valid())
+}
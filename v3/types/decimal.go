@@ -0,0 +1,165 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// decimalPattern plain decimal literal: optional sign, digits, optional fraction and optional exponent
+var decimalPattern = regexp.MustCompile(`^[+-]?(0|[1-9][0-9]*)(\.[0-9]+)?(?:[eE]([+-]?[0-9]+))?$`)
+
+// maxDecimalExponent bounds the exponent of a Decimal, keeping its big.Rat small. Far beyond float64 range.
+const maxDecimalExponent = 1000
+
+// Decimal arbitrary-precision decimal number that preserves the exact value CoinGecko sends, either as JSON number or
+// as JSON string. The zero value is 0; nullable values are held as *Decimal, nil when null.
+type Decimal struct {
+	value string
+}
+
+// NewDecimal parses s, e.g. "0.000000001234", into Decimal. Only plain decimal literals with an exponent within
+// ±1000 are accepted, so that the value is always a valid JSON number and a big.Rat; a leading plus sign is dropped.
+func NewDecimal(s string) (Decimal, error) {
+	match := decimalPattern.FindStringSubmatch(s)
+	if match == nil {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+
+	if exp := match[3]; exp != "" {
+		if e, err := strconv.Atoi(exp); err != nil || e < -maxDecimalExponent || e > maxDecimalExponent {
+			return Decimal{}, fmt.Errorf("invalid decimal %q: exponent out of range", s)
+		}
+	}
+
+	if _, ok := new(big.Rat).SetString(s); !ok {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+
+	return Decimal{value: strings.TrimPrefix(s, "+")}, nil
+}
+
+// String returns the exact decimal as received
+func (d Decimal) String() string {
+	if d.value == "" {
+		return "0"
+	}
+
+	return d.value
+}
+
+// Rat returns the exact value as big.Rat, never nil
+func (d Decimal) Rat() *big.Rat {
+	if r, ok := new(big.Rat).SetString(d.String()); ok {
+		return r
+	}
+
+	return new(big.Rat)
+}
+
+// Float64 returns the nearest float64 value, e.g. for charting
+func (d Decimal) Float64() float64 {
+	f, _ := d.Rat().Float64()
+	return f
+}
+
+// UnmarshalJSON leaves d unchanged on null, as encoding/json does for other types
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	v := strings.Trim(string(data), `"`)
+	if v == "" {
+		d.value = ""
+		return nil
+	}
+
+	parsed, err := NewDecimal(v)
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+
+	return nil
+}
+
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// float64Converter converts decoded Decimal values to their float64 fields, keeping the first value out of float64
+// range as err, so that UnmarshalJSON can fail instead of storing ±Inf
+type float64Converter struct {
+	err error
+}
+
+// value returns 0 when d is nil
+func (fc *float64Converter) value(d *Decimal) float64 {
+	if d == nil {
+		return 0
+	}
+
+	f := d.Float64()
+	if math.IsInf(f, 0) && fc.err == nil {
+		fc.err = fmt.Errorf("decimal %s out of float64 range", d)
+	}
+
+	return f
+}
+
+// ptr returns nil when d is nil
+func (fc *float64Converter) ptr(d *Decimal) *float64 {
+	if d == nil {
+		return nil
+	}
+
+	f := fc.value(d)
+
+	return &f
+}
+
+// all returns the exact values without null currencies, and their float64 values with 0 for null currencies.
+// Returns nil, nil when values is nil.
+func (fc *float64Converter) all(values map[string]*Decimal) (AllCurrenciesDecimal, AllCurrencies) {
+	if values == nil {
+		return nil, nil
+	}
+
+	decimals, floats := make(AllCurrenciesDecimal, len(values)), make(AllCurrencies, len(values))
+	for currency, d := range values {
+		floats[currency] = fc.value(d)
+		if d != nil {
+			decimals[currency] = *d
+		}
+	}
+
+	return decimals, floats
+}
+
+// decimalOrFloat returns d when set, f otherwise, so that values built with the float64 field only still marshal
+// under the shared JSON key. Returns nil, marshalled as null, when neither is set.
+func decimalOrFloat(d *Decimal, f *float64) interface{} {
+	if d != nil && d.value != "" {
+		return d
+	}
+
+	if f != nil {
+		return *f
+	}
+
+	return nil
+}
+
+// nonZero returns nil when f is 0, the value of a float64 field decoded from null
+func nonZero(f float64) *float64 {
+	if f == 0 {
+		return nil
+	}
+
+	return &f
+}
@@ -0,0 +1,182 @@
+package types
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestDecimal_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    string
+		wantErr bool
+	}{
+		{"valid: number", `0.00000000123456789012345`, "0.00000000123456789012345", false},
+		{"valid: string", `"41498226213.3908522937227766271"`, "41498226213.3908522937227766271", false},
+		{"valid: exponent", `1.5e-10`, "1.5e-10", false},
+		{"valid: null", `null`, "0", false},
+		{"invalid: not a number", `"abc"`, "0", true},
+		{"invalid: fraction", `"1/3"`, "0", true},
+		{"invalid: hexadecimal", `"0x10"`, "0", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Decimal
+			err := json.Unmarshal([]byte(tt.data), &got)
+
+			assert.Equal(t, tt.wantErr, err != nil, "Unmarshal(%s) = %v", tt.data, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestNewDecimal(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{"valid: integer", "42", "42", false},
+		{"valid: negative", "-0.5", "-0.5", false},
+		{"valid: plus sign", "+1.25", "1.25", false},
+		{"valid: exponent", "1E+10", "1E+10", false},
+		{"invalid: fraction", "1/3", "0", true},
+		{"invalid: hexadecimal", "0x10", "0", true},
+		{"invalid: sign only", "+", "0", true},
+		{"invalid: no integer part", ".5", "0", true},
+		{"invalid: no fraction digits", "1.", "0", true},
+		{"valid: bounded exponent", "1e-1000", "1e-1000", false},
+		{"invalid: huge exponent", "1e99999999", "0", true},
+		{"invalid: exponent out of range", "1e1001", "0", true},
+		{"invalid: leading zero", "01", "0", true},
+		{"invalid: empty exponent", "1e", "0", true},
+		{"invalid: infinity", "Inf", "0", true},
+		{"invalid: empty", "", "0", true},
+		{"invalid: whitespace", " 1", "0", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewDecimal(tt.s)
+
+			assert.Equal(t, tt.wantErr, err != nil, "NewDecimal(%q) = %v", tt.s, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestDecimal_Rat(t *testing.T) {
+	assert.Equal(t, 0, Decimal{}.Rat().Sign(), "zero value")
+	assert.Equal(t, 0, Decimal{value: "1e99999999"}.Rat().Sign(), "unparsable value")
+	assert.Equal(t, 0.0, Decimal{value: "1e99999999"}.Float64(), "unparsable value")
+
+	d, err := NewDecimal("1e-1000")
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, d.Float64(), "underflow")
+}
+
+func TestDecimal_roundTrip(t *testing.T) {
+	d, err := NewDecimal("0.000000001234567890123456789")
+	assert.NoError(t, err)
+
+	ba, err := json.Marshal(d)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.000000001234567890123456789", string(ba))
+
+	var got Decimal
+	assert.NoError(t, json.Unmarshal(ba, &got))
+	assert.Equal(t, d, got)
+	assert.Equal(t, 1.2345678901234568e-09, got.Float64())
+}
+
+func TestAllCurrenciesDecimal_Float64(t *testing.T) {
+	var acd AllCurrenciesDecimal
+	assert.NoError(t, json.Unmarshal([]byte(`{"usd":0.000000001234567890123,"btc":"1.5"}`), &acd))
+
+	assert.Equal(t, "0.000000001234567890123", acd["usd"].String())
+	assert.Equal(t, AllCurrencies{"usd": 1.234567890123e-09, "btc": 1.5}, acd.Float64())
+	assert.Nil(t, AllCurrenciesDecimal(nil).Float64())
+}
+
+func TestCoinsMarketItem_UnmarshalJSON(t *testing.T) {
+	var got CoinsMarketItem
+	data := `{"id":"pepe","current_price":0.00000123456789012345,"market_cap":519375918,"total_volume":null,"last_updated":""}`
+	assert.NoError(t, json.Unmarshal([]byte(data), &got))
+
+	assert.Equal(t, "pepe", got.ID, "got.ID")
+	assert.Equal(t, 1.23456789012345e-06, got.CurrentPrice, "got.CurrentPrice")
+	assert.Equal(t, "0.00000123456789012345", got.CurrentPriceDecimal.String(), "got.CurrentPriceDecimal")
+	assert.Equal(t, "519375918", got.MarketCapDecimal.String(), "got.MarketCapDecimal")
+	assert.Nil(t, got.TotalVolumeDecimal, "got.TotalVolumeDecimal")
+	assert.Equal(t, 0.0, got.TotalVolume, "got.TotalVolume")
+
+	ba, err := json.Marshal(got)
+	assert.NoError(t, err)
+	assert.Contains(t, string(ba), `"current_price":0.00000123456789012345`)
+	assert.Contains(t, string(ba), `"total_volume":null`)
+	assert.Contains(t, string(ba), `"last_updated":null`)
+
+	var again CoinsMarketItem
+	assert.NoError(t, json.Unmarshal(ba, &again))
+	assert.Equal(t, got, again)
+}
+
+func TestUnmarshalJSON_outOfRange(t *testing.T) {
+	for _, value := range []string{"1e99999999", "1e400", "-1e400"} {
+		assert.Error(t, json.Unmarshal([]byte(`{"current_price":`+value+`}`), new(CoinsMarketItem)), "CoinsMarketItem %s", value)
+		assert.Error(t, json.Unmarshal([]byte(`{"current_price":{"usd":`+value+`}}`), new(MarketDataItem)), "MarketDataItem %s", value)
+		assert.Error(t, json.Unmarshal([]byte(`{"last":`+value+`}`), new(TickerItem)), "TickerItem %s", value)
+		assert.Error(t, json.Unmarshal([]byte(`{"marketCap":`+value+`}`), new(SimplePriceCurrencyItem)), "SimplePriceCurrencyItem %s", value)
+	}
+}
+
+func TestTickerItem_roundTrip(t *testing.T) {
+	var got TickerItem
+	data := `{"base":"PEPE","last":0.000001234567890123456789,"volume":null,"timestamp":"2023-01-10T08:00:00Z",` +
+		`"last_traded_at":null,"last_fetch_at":""}`
+	assert.NoError(t, json.Unmarshal([]byte(data), &got))
+
+	assert.Equal(t, "0.000001234567890123456789", got.LastDecimal.String(), "got.LastDecimal")
+	assert.Equal(t, 1.234567890123456789e-06, got.Last, "got.Last")
+	assert.Nil(t, got.VolumeDecimal, "got.VolumeDecimal")
+	assert.Equal(t, time.Date(2023, time.January, 10, 8, 0, 0, 0, time.UTC), got.Timestamp.Time, "got.Timestamp")
+	assert.True(t, got.LastTradedAt.IsZero(), "got.LastTradedAt")
+	assert.True(t, got.LastFetchAt.IsZero(), "got.LastFetchAt")
+
+	ba, err := json.Marshal(got)
+	assert.NoError(t, err)
+	assert.Contains(t, string(ba), `"last_traded_at":null,"last_fetch_at":null`)
+
+	var again TickerItem
+	assert.NoError(t, json.Unmarshal(ba, &again))
+	assert.Equal(t, got, again)
+}
+
+func TestSimplePriceCurrencyItem_roundTrip(t *testing.T) {
+	price, err := NewDecimal("0.000748170000000001234")
+	assert.NoError(t, err)
+	marketCap, err := NewDecimal("44421437890.35498")
+	assert.NoError(t, err)
+
+	marketCapFloat := marketCap.Float64()
+	item := SimplePriceCurrencyItem{
+		Price:            price.Float64(),
+		PriceDecimal:     price,
+		MarketCap:        &marketCapFloat,
+		MarketCapDecimal: &marketCap,
+	}
+	ba, err := json.Marshal(item)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"price":0.000748170000000001234,"marketCap":44421437890.35498}`, string(ba))
+
+	var got SimplePriceCurrencyItem
+	assert.NoError(t, json.Unmarshal(ba, &got))
+	assert.Equal(t, item, got)
+
+	floatsOnly, err := json.Marshal(SimplePriceCurrencyItem{Price: 1.5})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"price":1.5}`, string(floatsOnly))
+}
@@ -2,6 +2,10 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -16,17 +20,27 @@ const (
 	CoinMarketOrderGeckoAsc
 	CoinMarketOrderVolumeAsc
 	CoinMarketOrderVolumeDesc
+	CoinMarketOrderIDAsc
+	CoinMarketOrderIDDesc
 )
 
+var coinsMarketOrders = []string{
+	"market_cap_desc",
+	"market_cap_asc",
+	"gecko_desc",
+	"gecko_asc",
+	"volume_asc",
+	"volume_desc",
+	"id_asc",
+	"id_desc",
+}
+
+func (cmo CoinsMarketOrder) Valid() bool {
+	return cmo >= 0 && int(cmo) < len(coinsMarketOrders)
+}
+
 func (cmo CoinsMarketOrder) String() string {
-	return []string{
-		"market_cap_desc",
-		"market_cap_asc",
-		"gecko_desc",
-		"gecko_asc",
-		"volume_asc",
-		"volume_desc",
-	}[cmo]
+	return coinsMarketOrders[cmo]
 }
 
 // PriceChangePercentage
@@ -41,18 +55,26 @@ const (
 	PriceChangePercentage30D
 	PriceChangePercentage200D
 	PriceChangePercentage1Y
+	PriceChangePercentage60D
 )
 
+var priceChangePercentages = []string{
+	"1h",
+	"24h",
+	"7d",
+	"14d",
+	"30d",
+	"200d",
+	"1y",
+	"60d",
+}
+
+func (pcp PriceChangePercentage) Valid() bool {
+	return pcp >= 0 && int(pcp) < len(priceChangePercentages)
+}
+
 func (pcp PriceChangePercentage) String() string {
-	return []string{
-		"1h",
-		"24h",
-		"7d",
-		"14d",
-		"30d",
-		"200d",
-		"1y",
-	}[pcp]
+	return priceChangePercentages[pcp]
 }
 
 type TickerOrder int
@@ -61,14 +83,294 @@ const (
 	TickerOrderTrustScoreDesc = iota
 	TickerOrderTrustScoreAsc
 	TickerOrderVolumeDesc
+	TickerOrderVolumeAsc
 )
 
+var tickerOrders = []string{
+	"trust_score_desc",
+	"trust_score_asc",
+	"volume_desc",
+	"volume_asc",
+}
+
+func (cto TickerOrder) Valid() bool {
+	return cto >= 0 && int(cto) < len(tickerOrders)
+}
+
 func (cto TickerOrder) String() string {
+	return tickerOrders[cto]
+}
+
+// DexPairFormat of the DEX tickers base and target
+type DexPairFormat int
+
+const (
+	DexPairFormatContractAddress DexPairFormat = iota
+	DexPairFormatSymbol
+)
+
+var dexPairFormats = []string{
+	"contract_address",
+	"symbol",
+}
+
+func (dpf DexPairFormat) Valid() bool {
+	return dpf >= 0 && int(dpf) < len(dexPairFormats)
+}
+
+func (dpf DexPairFormat) String() string {
+	return dexPairFormats[dpf]
+}
+
+type CoinsCategoriesOrder int
+
+const (
+	CoinsCategoriesOrderMarketCapDesc CoinsCategoriesOrder = iota
+	CoinsCategoriesOrderMarketCapAsc
+	CoinsCategoriesOrderNameDesc
+	CoinsCategoriesOrderNameAsc
+	CoinsCategoriesOrderMarketCapChange24hDesc
+	CoinsCategoriesOrderMarketCapChange24hAsc
+)
+
+var coinsCategoriesOrders = []string{
+	"market_cap_desc",
+	"market_cap_asc",
+	"name_desc",
+	"name_asc",
+	"market_cap_change_24h_desc",
+	"market_cap_change_24h_asc",
+}
+
+func (cco CoinsCategoriesOrder) Valid() bool {
+	return cco >= 0 && int(cco) < len(coinsCategoriesOrders)
+}
+
+func (cco CoinsCategoriesOrder) String() string {
+	return coinsCategoriesOrders[cco]
+}
+
+// MarketChartGranularity of the market chart data points
+type MarketChartGranularity int
+
+const (
+	MarketChartGranularityAuto MarketChartGranularity = iota
+	MarketChartGranularityFiveMinutes
+	MarketChartGranularityHourly
+	MarketChartGranularityDaily
+)
+
+var marketChartGranularities = []string{
+	"auto",
+	"5m",
+	"hourly",
+	"daily",
+}
+
+func (g MarketChartGranularity) Valid() bool {
+	return g >= 0 && int(g) < len(marketChartGranularities)
+}
+
+func (g MarketChartGranularity) String() string {
+	return marketChartGranularities[g]
+}
+
+// Duration returns the interval between data points, 0 for auto
+func (g MarketChartGranularity) Duration() time.Duration {
+	switch g {
+	case MarketChartGranularityFiveMinutes:
+		return 5 * time.Minute
+	case MarketChartGranularityHourly:
+		return time.Hour
+	case MarketChartGranularityDaily:
+		return 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// OHLCInterval of the CoinsIDOHLC candles. Non auto interval are only available to paid plan subscribers.
+type OHLCInterval int
+
+const (
+	OHLCIntervalAuto OHLCInterval = iota
+	OHLCIntervalDaily
+	OHLCIntervalHourly
+)
+
+func (i OHLCInterval) String() string {
+	return []string{
+		"",
+		"daily",
+		"hourly",
+	}[i]
+}
+
+// CoinsListStatus of the coins listed by CoinsList
+type CoinsListStatus int
+
+const (
+	CoinsListStatusActive CoinsListStatus = iota
+	CoinsListStatusInactive
+)
+
+var coinsListStatuses = []string{
+	"active",
+	"inactive",
+}
+
+func (cls CoinsListStatus) Valid() bool {
+	return cls >= 0 && int(cls) < len(coinsListStatuses)
+}
+
+func (cls CoinsListStatus) String() string {
+	return coinsListStatuses[cls]
+}
+
+type AssetPlatformsFilter int
+
+const (
+	AssetPlatformsFilterNone AssetPlatformsFilter = iota
+	AssetPlatformsFilterNFT
+)
+
+func (apf AssetPlatformsFilter) String() string {
+	return []string{
+		"",
+		"nft",
+	}[apf]
+}
+
+type DerivativesExchangesOrder int
+
+const (
+	DerivativesExchangesOrderOpenInterestBtcDesc DerivativesExchangesOrder = iota
+	DerivativesExchangesOrderOpenInterestBtcAsc
+	DerivativesExchangesOrderTradeVolume24hBtcDesc
+	DerivativesExchangesOrderTradeVolume24hBtcAsc
+	DerivativesExchangesOrderNameDesc
+	DerivativesExchangesOrderNameAsc
+)
+
+var derivativesExchangesOrders = []string{
+	"open_interest_btc_desc",
+	"open_interest_btc_asc",
+	"trade_volume_24h_btc_desc",
+	"trade_volume_24h_btc_asc",
+	"name_desc",
+	"name_asc",
+}
+
+func (deo DerivativesExchangesOrder) Valid() bool {
+	return deo >= 0 && int(deo) < len(derivativesExchangesOrders)
+}
+
+func (deo DerivativesExchangesOrder) String() string {
+	return derivativesExchangesOrders[deo]
+}
+
+type DerivativesIncludeTickers int
+
+const (
+	DerivativesIncludeTickersNone DerivativesIncludeTickers = iota
+	DerivativesIncludeTickersAll
+	DerivativesIncludeTickersUnexpired
+)
+
+func (dit DerivativesIncludeTickers) String() string {
 	return []string{
-		"trust_score_desc",
-		"trust_score_asc",
-		"volume_desc",
-	}[cto]
+		"",
+		"all",
+		"unexpired",
+	}[dit]
+}
+
+type NFTsListOrder int
+
+const (
+	NFTsListOrderNone NFTsListOrder = iota
+	NFTsListOrderH24VolumeNativeDesc
+	NFTsListOrderH24VolumeNativeAsc
+	NFTsListOrderFloorPriceNativeDesc
+	NFTsListOrderFloorPriceNativeAsc
+	NFTsListOrderMarketCapNativeDesc
+	NFTsListOrderMarketCapNativeAsc
+	NFTsListOrderMarketCapUsdDesc
+	NFTsListOrderMarketCapUsdAsc
+)
+
+var nftsListOrders = []string{
+	"",
+	"h24_volume_native_desc",
+	"h24_volume_native_asc",
+	"floor_price_native_desc",
+	"floor_price_native_asc",
+	"market_cap_native_desc",
+	"market_cap_native_asc",
+	"market_cap_usd_desc",
+	"market_cap_usd_asc",
+}
+
+func (nlo NFTsListOrder) Valid() bool {
+	return nlo >= 0 && int(nlo) < len(nftsListOrders)
+}
+
+func (nlo NFTsListOrder) String() string {
+	return nftsListOrders[nlo]
+}
+
+type TopGainersLosersDuration int
+
+const (
+	TopGainersLosersDuration24H TopGainersLosersDuration = iota
+	TopGainersLosersDuration1H
+	TopGainersLosersDuration7D
+	TopGainersLosersDuration14D
+	TopGainersLosersDuration30D
+	TopGainersLosersDuration60D
+	TopGainersLosersDuration1Y
+)
+
+var topGainersLosersDurations = []string{
+	"24h",
+	"1h",
+	"7d",
+	"14d",
+	"30d",
+	"60d",
+	"1y",
+}
+
+func (d TopGainersLosersDuration) Valid() bool {
+	return d >= 0 && int(d) < len(topGainersLosersDurations)
+}
+
+func (d TopGainersLosersDuration) String() string {
+	return topGainersLosersDurations[d]
+}
+
+type TopGainersLosersTopCoins int
+
+const (
+	TopGainersLosersTopCoins1000 TopGainersLosersTopCoins = iota
+	TopGainersLosersTopCoins300
+	TopGainersLosersTopCoins500
+	TopGainersLosersTopCoinsAll
+)
+
+var topGainersLosersTopCoins = []string{
+	"1000",
+	"300",
+	"500",
+	"all",
+}
+
+func (tc TopGainersLosersTopCoins) Valid() bool {
+	return tc >= 0 && int(tc) < len(topGainersLosersTopCoins)
+}
+
+func (tc TopGainersLosersTopCoins) String() string {
+	return topGainersLosersTopCoins[tc]
 }
 
 // SHARED
@@ -76,6 +378,64 @@ func (cto TickerOrder) String() string {
 // AllCurrencies map all currencies (USD, BTC) to float64
 type AllCurrencies map[string]float64
 
+// AllCurrenciesDecimal map all currencies (USD, BTC) to their exact Decimal value. Currencies with null value are
+// left out.
+type AllCurrenciesDecimal map[string]Decimal
+
+func (acd *AllCurrenciesDecimal) UnmarshalJSON(data []byte) error {
+	var values map[string]*Decimal
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	if values == nil {
+		*acd = nil
+		return nil
+	}
+
+	r := make(AllCurrenciesDecimal, len(values))
+	for currency, d := range values {
+		if d != nil {
+			r[currency] = *d
+		}
+	}
+	*acd = r
+
+	return nil
+}
+
+// decimalsOrFloats returns floats with their exact decimals when set. Currencies with 0 and no decimal, decoded from
+// null, marshal as null, see decimalOrFloat.
+func decimalsOrFloats(decimals AllCurrenciesDecimal, floats AllCurrencies) interface{} {
+	if decimals == nil && floats == nil {
+		return nil
+	}
+
+	r := make(map[string]interface{}, len(floats))
+	for currency, f := range floats {
+		r[currency] = decimalOrFloat(nil, nonZero(f))
+	}
+	for currency, d := range decimals {
+		r[currency] = d
+	}
+
+	return r
+}
+
+// Float64 converts to AllCurrencies, e.g. for charting
+func (acd AllCurrenciesDecimal) Float64() AllCurrencies {
+	if acd == nil {
+		return nil
+	}
+
+	r := make(AllCurrencies, len(acd))
+	for currency, d := range acd {
+		r[currency] = d.Float64()
+	}
+
+	return r
+}
+
 // LocalizationItem map all locale (en, zh) into respective string
 type LocalizationItem map[string]string
 
@@ -87,6 +447,150 @@ type DescriptionItem map[string]string
 // LinksItem map all links
 type LinksItem map[string]interface{}
 
+// DetailPlatforms map asset platform id to its DetailPlatformItem
+type DetailPlatforms map[string]DetailPlatformItem
+
+// DetailPlatformItem contract details of a coin on an asset platform
+type DetailPlatformItem struct {
+	DecimalPlace    *int   `json:"decimal_place"`
+	ContractAddress string `json:"contract_address"`
+}
+
+// StringFloat64 float64 that CoinGecko encodes either as JSON number or as JSON string, e.g. "574366.94"
+type StringFloat64 float64
+
+func (sf *StringFloat64) UnmarshalJSON(data []byte) error {
+	v := strings.Trim(string(data), `"`)
+	if v == "" || v == "null" {
+		*sf = 0
+		return nil
+	}
+
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return fmt.Errorf("invalid number %s: %v", data, err)
+	}
+
+	*sf = StringFloat64(f)
+
+	return nil
+}
+
+// float64Ptr returns nil when sf is nil
+func (sf *StringFloat64) float64Ptr() *float64 {
+	if sf == nil {
+		return nil
+	}
+
+	f := float64(*sf)
+
+	return &f
+}
+
+// toFloat64Map converts m, keeping nil as nil
+func toFloat64Map(m map[string]StringFloat64) map[string]float64 {
+	if m == nil {
+		return nil
+	}
+
+	r := make(map[string]float64, len(m))
+	for k, v := range m {
+		r[k] = float64(v)
+	}
+
+	return r
+}
+
+// UnixTime time.Time that is encoded as unix seconds in JSON
+type UnixTime struct {
+	time.Time
+}
+
+func (ut *UnixTime) UnmarshalJSON(data []byte) error {
+	v := strings.Trim(string(data), `"`)
+	if v == "" || v == "null" {
+		ut.Time = time.Time{}
+		return nil
+	}
+
+	secs, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return fmt.Errorf("invalid unix time %s: %v", data, err)
+	}
+
+	ut.Time = time.Unix(int64(secs), 0).UTC()
+
+	return nil
+}
+
+func (ut UnixTime) MarshalJSON() ([]byte, error) {
+	if ut.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return []byte(strconv.FormatInt(ut.Unix(), 10)), nil
+}
+
+// DateTime time.Time that is encoded as RFC 3339 string in JSON, e.g. "2021-11-10T14:24:11.849Z". Null and empty
+// string decode to the zero value.
+type DateTime struct {
+	time.Time
+}
+
+func (dt *DateTime) UnmarshalJSON(data []byte) error {
+	t, err := parseTime(data, time.RFC3339Nano, dateLayout)
+	dt.Time = t
+
+	return err
+}
+
+func (dt DateTime) MarshalJSON() ([]byte, error) {
+	if dt.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(dt.UTC().Format(time.RFC3339Nano))
+}
+
+// Date time.Time that is encoded as yyyy-mm-dd string in JSON, e.g. "2013-12-08". Null and empty string decode to
+// the zero value.
+type Date struct {
+	time.Time
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	t, err := parseTime(data, dateLayout, time.RFC3339Nano)
+	d.Time = t
+
+	return err
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(d.UTC().Format(dateLayout))
+}
+
+const dateLayout = "2006-01-02"
+
+// parseTime parses the JSON string data with the first matching layout into UTC
+func parseTime(data []byte, layouts ...string) (time.Time, error) {
+	v := strings.Trim(string(data), `"`)
+	if v == "" || v == "null" {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t.UTC(), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %s", data)
+}
+
 // ChartItem
 
 type ChartItem struct {
@@ -95,57 +599,159 @@ type ChartItem struct {
 }
 
 func (ci *ChartItem) UnmarshalJSON(data []byte) error {
-	var content [2]float64
+	var content [2]StringFloat64
 	if err := json.Unmarshal(data, &content); err != nil {
 		return err
 	}
 
 	ci.Time = time.Unix(0, int64(content[0])*int64(time.Millisecond))
-	ci.Value = content[1]
+	ci.Value = float64(content[1])
+
+	return nil
+}
+
+// OHLCItem candle in CoinsIDOHLC, Time is the candle close time
+type OHLCItem struct {
+	Time  time.Time
+	Open  float64
+	High  float64
+	Low   float64
+	Close float64
+}
+
+func (oi *OHLCItem) UnmarshalJSON(data []byte) error {
+	var content [5]float64
+	if err := json.Unmarshal(data, &content); err != nil {
+		return err
+	}
+
+	oi.Time = time.Unix(0, int64(content[0])*int64(time.Millisecond))
+	oi.Open = content[1]
+	oi.High = content[2]
+	oi.Low = content[3]
+	oi.Close = content[4]
 
 	return nil
 }
 
+// SortUniqueChartItems returns a copy of items sorted by time, without items of duplicate time, keeping the first
+// occurrence. items is left unchanged.
+func SortUniqueChartItems(items []ChartItem) []ChartItem {
+	sorted := append([]ChartItem(nil), items...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Time.Before(sorted[j].Time)
+	})
+
+	r := make([]ChartItem, 0, len(sorted))
+	for i, item := range sorted {
+		if i == 0 || !item.Time.Equal(r[len(r)-1].Time) {
+			r = append(r, item)
+		}
+	}
+
+	return r
+}
+
+// ResampleChartItems returns a copy of items keeping the first item of every interval, aligned to UTC. Items must be
+// sorted by time. items is left unchanged.
+func ResampleChartItems(items []ChartItem, interval time.Duration) []ChartItem {
+	if interval <= 0 {
+		return append([]ChartItem(nil), items...)
+	}
+
+	r := make([]ChartItem, 0, len(items))
+	for i, item := range items {
+		if i == 0 || !item.Time.Truncate(interval).Equal(r[len(r)-1].Time.Truncate(interval)) {
+			r = append(r, item)
+		}
+	}
+
+	return r
+}
+
 // MarketDataItem map all market data item
 type MarketDataItem struct {
-	CurrentPrice                           AllCurrencies     `json:"current_price"`
-	ROI                                    *ROIItem          `json:"roi"`
-	ATH                                    AllCurrencies     `json:"ath"`
-	ATHChangePercentage                    AllCurrencies     `json:"ath_change_percentage"`
-	ATHDate                                map[string]string `json:"ath_date"`
-	ATL                                    AllCurrencies     `json:"atl"`
-	ATLChangePercentage                    AllCurrencies     `json:"atl_change_percentage"`
-	ATLDate                                map[string]string `json:"atl_date"`
-	MarketCap                              AllCurrencies     `json:"market_cap"`
-	MarketCapRank                          uint16            `json:"market_cap_rank"`
-	TotalVolume                            AllCurrencies     `json:"total_volume"`
-	High24                                 AllCurrencies     `json:"high_24h"`
-	Low24                                  AllCurrencies     `json:"low_24h"`
-	PriceChange24h                         float64           `json:"price_change_24h"`
-	PriceChangePercentage24h               float64           `json:"price_change_percentage_24h"`
-	PriceChangePercentage7d                float64           `json:"price_change_percentage_7d"`
-	PriceChangePercentage14d               float64           `json:"price_change_percentage_14d"`
-	PriceChangePercentage30d               float64           `json:"price_change_percentage_30d"`
-	PriceChangePercentage60d               float64           `json:"price_change_percentage_60d"`
-	PriceChangePercentage200d              float64           `json:"price_change_percentage_200d"`
-	PriceChangePercentage1y                float64           `json:"price_change_percentage_1y"`
-	MarketCapChange24h                     float64           `json:"market_cap_change_24h"`
-	MarketCapChangePercentage24h           float64           `json:"market_cap_change_percentage_24h"`
-	PriceChange24hInCurrency               AllCurrencies     `json:"price_change_24h_in_currency"`
-	PriceChangePercentage1hInCurrency      AllCurrencies     `json:"price_change_percentage_1h_in_currency"`
-	PriceChangePercentage24hInCurrency     AllCurrencies     `json:"price_change_percentage_24h_in_currency"`
-	PriceChangePercentage7dInCurrency      AllCurrencies     `json:"price_change_percentage_7d_in_currency"`
-	PriceChangePercentage14dInCurrency     AllCurrencies     `json:"price_change_percentage_14d_in_currency"`
-	PriceChangePercentage30dInCurrency     AllCurrencies     `json:"price_change_percentage_30d_in_currency"`
-	PriceChangePercentage60dInCurrency     AllCurrencies     `json:"price_change_percentage_60d_in_currency"`
-	PriceChangePercentage200dInCurrency    AllCurrencies     `json:"price_change_percentage_200d_in_currency"`
-	PriceChangePercentage1yInCurrency      AllCurrencies     `json:"price_change_percentage_1y_in_currency"`
-	MarketCapChange24hInCurrency           AllCurrencies     `json:"market_cap_change_24h_in_currency"`
-	MarketCapChangePercentage24hInCurrency AllCurrencies     `json:"market_cap_change_percentage_24h_in_currency"`
-	TotalSupply                            *float64          `json:"total_supply"`
-	CirculatingSupply                      float64           `json:"circulating_supply"`
-	Sparkline                              *SparklineItem    `json:"sparkline_7d"`
-	LastUpdated                            string            `json:"last_updated"`
+	CurrentPrice                           AllCurrencies       `json:"current_price"`
+	ROI                                    *ROIItem            `json:"roi"`
+	ATH                                    AllCurrencies       `json:"ath"`
+	ATHChangePercentage                    AllCurrencies       `json:"ath_change_percentage"`
+	ATHDate                                map[string]DateTime `json:"ath_date"`
+	ATL                                    AllCurrencies       `json:"atl"`
+	ATLChangePercentage                    AllCurrencies       `json:"atl_change_percentage"`
+	ATLDate                                map[string]DateTime `json:"atl_date"`
+	MarketCap                              AllCurrencies       `json:"market_cap"`
+	MarketCapRank                          uint16              `json:"market_cap_rank"`
+	TotalVolume                            AllCurrencies       `json:"total_volume"`
+	High24                                 AllCurrencies       `json:"high_24h"`
+	Low24                                  AllCurrencies       `json:"low_24h"`
+	PriceChange24h                         float64             `json:"price_change_24h"`
+	PriceChangePercentage24h               float64             `json:"price_change_percentage_24h"`
+	PriceChangePercentage7d                float64             `json:"price_change_percentage_7d"`
+	PriceChangePercentage14d               float64             `json:"price_change_percentage_14d"`
+	PriceChangePercentage30d               float64             `json:"price_change_percentage_30d"`
+	PriceChangePercentage60d               float64             `json:"price_change_percentage_60d"`
+	PriceChangePercentage200d              float64             `json:"price_change_percentage_200d"`
+	PriceChangePercentage1y                float64             `json:"price_change_percentage_1y"`
+	MarketCapChange24h                     float64             `json:"market_cap_change_24h"`
+	MarketCapChangePercentage24h           float64             `json:"market_cap_change_percentage_24h"`
+	PriceChange24hInCurrency               AllCurrencies       `json:"price_change_24h_in_currency"`
+	PriceChangePercentage1hInCurrency      AllCurrencies       `json:"price_change_percentage_1h_in_currency"`
+	PriceChangePercentage24hInCurrency     AllCurrencies       `json:"price_change_percentage_24h_in_currency"`
+	PriceChangePercentage7dInCurrency      AllCurrencies       `json:"price_change_percentage_7d_in_currency"`
+	PriceChangePercentage14dInCurrency     AllCurrencies       `json:"price_change_percentage_14d_in_currency"`
+	PriceChangePercentage30dInCurrency     AllCurrencies       `json:"price_change_percentage_30d_in_currency"`
+	PriceChangePercentage60dInCurrency     AllCurrencies       `json:"price_change_percentage_60d_in_currency"`
+	PriceChangePercentage200dInCurrency    AllCurrencies       `json:"price_change_percentage_200d_in_currency"`
+	PriceChangePercentage1yInCurrency      AllCurrencies       `json:"price_change_percentage_1y_in_currency"`
+	MarketCapChange24hInCurrency           AllCurrencies       `json:"market_cap_change_24h_in_currency"`
+	MarketCapChangePercentage24hInCurrency AllCurrencies       `json:"market_cap_change_percentage_24h_in_currency"`
+	TotalSupply                            *float64            `json:"total_supply"`
+	CirculatingSupply                      float64             `json:"circulating_supply"`
+	Sparkline                              *SparklineItem      `json:"sparkline_7d"`
+	LastUpdated                            DateTime            `json:"last_updated"`
+
+	// Exact values of CurrentPrice, MarketCap and TotalVolume. Currencies with null value are left out here, and are 0
+	// in the float64 maps.
+	CurrentPriceDecimal AllCurrenciesDecimal `json:"-"`
+	MarketCapDecimal    AllCurrenciesDecimal `json:"-"`
+	TotalVolumeDecimal  AllCurrenciesDecimal `json:"-"`
+}
+
+// UnmarshalJSON decodes CurrentPrice, MarketCap and TotalVolume from their exact Decimal values
+func (mdi *MarketDataItem) UnmarshalJSON(data []byte) error {
+	type marketDataItem MarketDataItem
+	aux := struct {
+		*marketDataItem
+		CurrentPrice map[string]*Decimal `json:"current_price"`
+		MarketCap    map[string]*Decimal `json:"market_cap"`
+		TotalVolume  map[string]*Decimal `json:"total_volume"`
+	}{marketDataItem: (*marketDataItem)(mdi)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var fc float64Converter
+	mdi.CurrentPriceDecimal, mdi.CurrentPrice = fc.all(aux.CurrentPrice)
+	mdi.MarketCapDecimal, mdi.MarketCap = fc.all(aux.MarketCap)
+	mdi.TotalVolumeDecimal, mdi.TotalVolume = fc.all(aux.TotalVolume)
+
+	return fc.err
+}
+
+// MarshalJSON encodes CurrentPrice, MarketCap and TotalVolume with their exact Decimal values
+func (mdi MarketDataItem) MarshalJSON() ([]byte, error) {
+	type marketDataItem MarketDataItem
+	return json.Marshal(struct {
+		marketDataItem
+		CurrentPrice interface{} `json:"current_price"`
+		MarketCap    interface{} `json:"market_cap"`
+		TotalVolume  interface{} `json:"total_volume"`
+	}{
+		marketDataItem: marketDataItem(mdi),
+		CurrentPrice:   decimalsOrFloats(mdi.CurrentPriceDecimal, mdi.CurrentPrice),
+		MarketCap:      decimalsOrFloats(mdi.MarketCapDecimal, mdi.MarketCap),
+		TotalVolume:    decimalsOrFloats(mdi.TotalVolumeDecimal, mdi.TotalVolume),
+	})
 }
 
 // CommunityDataItem map all community data item
@@ -213,24 +819,61 @@ type TickerItem struct {
 	ConvertedVolume        map[string]float64 `json:"converted_volume"`
 	TrustScore             string             `json:"trust_score"`
 	BidAskSpreadPercentage float64            `json:"bid_ask_spread_percentage"`
-	Timestamp              time.Time          `json:"timestamp"`
-	LastTradedAt           time.Time          `json:"last_traded_at"`
-	LastFetchAt            time.Time          `json:"last_fetch_at"`
+	Timestamp              DateTime           `json:"timestamp"`
+	LastTradedAt           DateTime           `json:"last_traded_at"`
+	LastFetchAt            DateTime           `json:"last_fetch_at"`
 	IsAnomaly              bool               `json:"is_anomaly"`
 	IsStale                bool               `json:"is_stale"`
 	TradeUrl               string             `json:"trade_url"`
 	CoinID                 string             `json:"coin_id"`
 	TargetCoinID           string             `json:"target_coin_id,omitempty"`
+
+	// Exact values of Last and Volume, nil when null
+	LastDecimal   *Decimal `json:"-"`
+	VolumeDecimal *Decimal `json:"-"`
+}
+
+// UnmarshalJSON decodes Last and Volume from their exact Decimal values
+func (ti *TickerItem) UnmarshalJSON(data []byte) error {
+	type tickerItem TickerItem
+	aux := struct {
+		*tickerItem
+		Last   *Decimal `json:"last"`
+		Volume *Decimal `json:"volume"`
+	}{tickerItem: (*tickerItem)(ti)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var fc float64Converter
+	ti.LastDecimal, ti.Last = aux.Last, fc.value(aux.Last)
+	ti.VolumeDecimal, ti.Volume = aux.Volume, fc.value(aux.Volume)
+
+	return fc.err
+}
+
+// MarshalJSON encodes Last and Volume with their exact Decimal values
+func (ti TickerItem) MarshalJSON() ([]byte, error) {
+	type tickerItem TickerItem
+	return json.Marshal(struct {
+		tickerItem
+		Last   interface{} `json:"last"`
+		Volume interface{} `json:"volume"`
+	}{
+		tickerItem: tickerItem(ti),
+		Last:       decimalOrFloat(ti.LastDecimal, nonZero(ti.Last)),
+		Volume:     decimalOrFloat(ti.VolumeDecimal, nonZero(ti.Volume)),
+	})
 }
 
 // StatusUpdateItem for BEAM
 type StatusUpdateItem struct {
-	Description string `json:"description"`
-	Category    string `json:"category"`
-	CreatedAt   string `json:"created_at"`
-	User        string `json:"user"`
-	UserTitle   string `json:"user_title"`
-	Pin         bool   `json:"pin"`
+	Description string   `json:"description"`
+	Category    string   `json:"category"`
+	CreatedAt   DateTime `json:"created_at"`
+	User        string   `json:"user"`
+	UserTitle   string   `json:"user_title"`
+	Pin         bool     `json:"pin"`
 	Project     struct {
 		Type  string    `json:"type"`
 		ID    string    `json:"id"`
@@ -245,7 +888,7 @@ type CoinsListItem struct {
 	Symbol string `json:"symbol"`
 	Name   string `json:"name"`
 
-	Platforms map[string]string `json:"platforms"`
+	Platforms map[string]string `json:"platforms"` // Contract address keyed by asset platform id. Only set with CoinsListParams.IncludePlatform.
 }
 
 // CoinsMarketItem item in CoinMarket
@@ -268,17 +911,60 @@ type CoinsMarketItem struct {
 	TotalSupply                         float64        `json:"total_supply"`
 	ATH                                 float64        `json:"ath"`
 	ATHChangePercentage                 float64        `json:"ath_change_percentage"`
-	ATHDate                             string         `json:"ath_date"`
+	ATHDate                             DateTime       `json:"ath_date"`
 	ROI                                 *ROIItem       `json:"roi"`
-	LastUpdated                         time.Time      `json:"last_updated"`
+	LastUpdated                         DateTime       `json:"last_updated"`
 	SparklineIn7d                       *SparklineItem `json:"sparkline_in_7d"`
 	PriceChangePercentage1hInCurrency   *float64       `json:"price_change_percentage_1h_in_currency"`
 	PriceChangePercentage24hInCurrency  *float64       `json:"price_change_percentage_24h_in_currency"`
 	PriceChangePercentage7dInCurrency   *float64       `json:"price_change_percentage_7d_in_currency"`
 	PriceChangePercentage14dInCurrency  *float64       `json:"price_change_percentage_14d_in_currency"`
 	PriceChangePercentage30dInCurrency  *float64       `json:"price_change_percentage_30d_in_currency"`
+	PriceChangePercentage60dInCurrency  *float64       `json:"price_change_percentage_60d_in_currency"`
 	PriceChangePercentage200dInCurrency *float64       `json:"price_change_percentage_200d_in_currency"`
 	PriceChangePercentage1yInCurrency   *float64       `json:"price_change_percentage_1y_in_currency"`
+
+	// Exact values of CurrentPrice, MarketCap and TotalVolume, nil when null
+	CurrentPriceDecimal *Decimal `json:"-"`
+	MarketCapDecimal    *Decimal `json:"-"`
+	TotalVolumeDecimal  *Decimal `json:"-"`
+}
+
+// UnmarshalJSON decodes CurrentPrice, MarketCap and TotalVolume from their exact Decimal values
+func (cmi *CoinsMarketItem) UnmarshalJSON(data []byte) error {
+	type coinsMarketItem CoinsMarketItem
+	aux := struct {
+		*coinsMarketItem
+		CurrentPrice *Decimal `json:"current_price"`
+		MarketCap    *Decimal `json:"market_cap"`
+		TotalVolume  *Decimal `json:"total_volume"`
+	}{coinsMarketItem: (*coinsMarketItem)(cmi)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var fc float64Converter
+	cmi.CurrentPriceDecimal, cmi.CurrentPrice = aux.CurrentPrice, fc.value(aux.CurrentPrice)
+	cmi.MarketCapDecimal, cmi.MarketCap = aux.MarketCap, fc.value(aux.MarketCap)
+	cmi.TotalVolumeDecimal, cmi.TotalVolume = aux.TotalVolume, fc.value(aux.TotalVolume)
+
+	return fc.err
+}
+
+// MarshalJSON encodes CurrentPrice, MarketCap and TotalVolume with their exact Decimal values
+func (cmi CoinsMarketItem) MarshalJSON() ([]byte, error) {
+	type coinsMarketItem CoinsMarketItem
+	return json.Marshal(struct {
+		coinsMarketItem
+		CurrentPrice interface{} `json:"current_price"`
+		MarketCap    interface{} `json:"market_cap"`
+		TotalVolume  interface{} `json:"total_volume"`
+	}{
+		coinsMarketItem: coinsMarketItem(cmi),
+		CurrentPrice:    decimalOrFloat(cmi.CurrentPriceDecimal, nonZero(cmi.CurrentPrice)),
+		MarketCap:       decimalOrFloat(cmi.MarketCapDecimal, nonZero(cmi.MarketCap)),
+		TotalVolume:     decimalOrFloat(cmi.TotalVolumeDecimal, nonZero(cmi.TotalVolume)),
+	})
 }
 
 // EventCountryItem item in EventsCountries
@@ -305,5 +991,315 @@ type Global struct {
 	TotalVolume                     AllCurrencies `json:"total_volume"`
 	MarketCapPercentage             AllCurrencies `json:"market_cap_percentage"`
 	MarketCapChangePercentage24hUSD float64       `json:"market_cap_change_percentage_24h_usd"`
-	UpdatedAt                       int64         `json:"updated_at"`
+	UpdatedAt                       UnixTime      `json:"updated_at"`
+}
+
+// SearchCoinItem item in Search.Coins
+type SearchCoinItem struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	APISymbol     string `json:"api_symbol"`
+	Symbol        string `json:"symbol"`
+	MarketCapRank *int   `json:"market_cap_rank"`
+	Thumb         string `json:"thumb"`
+	Large         string `json:"large"`
+}
+
+// SearchExchangeItem item in Search.Exchanges
+type SearchExchangeItem struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	MarketType string `json:"market_type"`
+	Thumb      string `json:"thumb"`
+	Large      string `json:"large"`
+}
+
+// SearchCategoryItem item in Search.Categories
+type SearchCategoryItem struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// SearchNFTItem item in Search.NFTs
+type SearchNFTItem struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Symbol string `json:"symbol"`
+	Thumb  string `json:"thumb"`
+}
+
+// TrendingCoinItem item in SearchTrending.Coins
+type TrendingCoinItem struct {
+	Item TrendingCoin `json:"item"`
+}
+
+// TrendingCoin coin trending on CoinGecko
+type TrendingCoin struct {
+	ID            string            `json:"id"`
+	CoinID        int               `json:"coin_id"`
+	Name          string            `json:"name"`
+	Symbol        string            `json:"symbol"`
+	MarketCapRank *int              `json:"market_cap_rank"`
+	Thumb         string            `json:"thumb"`
+	Small         string            `json:"small"`
+	Large         string            `json:"large"`
+	Slug          string            `json:"slug"`
+	PriceBtc      *float64          `json:"price_btc"`
+	Score         int               `json:"score"` // Position in the trending list, starts from 0
+	Data          *TrendingCoinData `json:"data"`
+}
+
+// TrendingCoinData market data of TrendingCoin. MarketCap and TotalVolume are display strings, e.g. "$1,234,567"
+type TrendingCoinData struct {
+	Price                    *float64      `json:"price"`
+	PriceBtc                 string        `json:"price_btc"`
+	PriceChangePercentage24h AllCurrencies `json:"price_change_percentage_24h"`
+	MarketCap                string        `json:"market_cap"`
+	MarketCapBtc             string        `json:"market_cap_btc"`
+	TotalVolume              string        `json:"total_volume"`
+	TotalVolumeBtc           string        `json:"total_volume_btc"`
+	Sparkline                string        `json:"sparkline"` // Sparkline image URL
+}
+
+// TrendingNFTItem item in SearchTrending.NFTs
+type TrendingNFTItem struct {
+	ID                            string           `json:"id"`
+	Name                          string           `json:"name"`
+	Symbol                        string           `json:"symbol"`
+	Thumb                         string           `json:"thumb"`
+	NFTContractID                 int              `json:"nft_contract_id"`
+	NativeCurrencySymbol          string           `json:"native_currency_symbol"`
+	FloorPriceInNativeCurrency    *float64         `json:"floor_price_in_native_currency"`
+	FloorPrice24hPercentageChange *float64         `json:"floor_price_24h_percentage_change"`
+	Data                          *TrendingNFTData `json:"data"`
+}
+
+// TrendingNFTData market data of TrendingNFTItem, all values are display strings
+type TrendingNFTData struct {
+	FloorPrice                         string `json:"floor_price"`
+	FloorPriceInUsd24hPercentageChange string `json:"floor_price_in_usd_24h_percentage_change"`
+	H24Volume                          string `json:"h24_volume"`
+	H24AverageSalePrice                string `json:"h24_average_sale_price"`
+	Sparkline                          string `json:"sparkline"` // Sparkline image URL
+}
+
+// TrendingCategoryItem item in SearchTrending.Categories
+type TrendingCategoryItem struct {
+	ID                int                   `json:"id"`
+	Name              string                `json:"name"`
+	Slug              string                `json:"slug"`
+	CoinsCount        *int                  `json:"coins_count"`
+	MarketCap1hChange *float64              `json:"market_cap_1h_change"`
+	Data              *TrendingCategoryData `json:"data"`
+}
+
+// TrendingCategoryData market data of TrendingCategoryItem
+type TrendingCategoryData struct {
+	MarketCap                    *float64      `json:"market_cap"`
+	MarketCapBtc                 *float64      `json:"market_cap_btc"`
+	TotalVolume                  *float64      `json:"total_volume"`
+	TotalVolumeBtc               *float64      `json:"total_volume_btc"`
+	MarketCapChangePercentage24h AllCurrencies `json:"market_cap_change_percentage_24h"`
+	Sparkline                    string        `json:"sparkline"` // Sparkline image URL
+}
+
+// CoinsCategoryItem item in CoinsCategories
+type CoinsCategoryItem struct {
+	ID                 string   `json:"id"`
+	Name               string   `json:"name"`
+	MarketCap          *float64 `json:"market_cap"`
+	MarketCapChange24h *float64 `json:"market_cap_change_24h"`
+	Content            string   `json:"content"`
+	Top3Coins          []string `json:"top_3_coins"` // Image URLs of the top 3 coins
+	Volume24h          *float64 `json:"volume_24h"`
+	UpdatedAt          DateTime `json:"updated_at"`
+}
+
+// AssetPlatformItem item in AssetPlatforms
+type AssetPlatformItem struct {
+	ID              string  `json:"id"`
+	ChainIdentifier *int64  `json:"chain_identifier"` // EVM chain id, nil for non EVM platforms
+	Name            string  `json:"name"`
+	Shortname       string  `json:"shortname"`
+	NativeCoinID    *string `json:"native_coin_id"`
+}
+
+// DerivativeItem item in Derivatives
+type DerivativeItem struct {
+	Market                   string    `json:"market"`
+	Symbol                   string    `json:"symbol"`
+	IndexID                  string    `json:"index_id"`
+	Price                    *float64  `json:"price"`
+	PricePercentageChange24h *float64  `json:"price_percentage_change_24h"`
+	ContractType             string    `json:"contract_type"` // perpetual or futures
+	Index                    *float64  `json:"index"`         // Index price
+	Basis                    *float64  `json:"basis"`
+	Spread                   *float64  `json:"spread"`
+	FundingRate              *float64  `json:"funding_rate"`
+	OpenInterest             *float64  `json:"open_interest"`
+	Volume24h                *float64  `json:"volume_24h"`
+	LastTradedAt             UnixTime  `json:"last_traded_at"`
+	ExpiredAt                *UnixTime `json:"expired_at"` // nil for perpetual contracts
+}
+
+func (di *DerivativeItem) UnmarshalJSON(data []byte) error {
+	type derivativeItem DerivativeItem
+	aux := struct {
+		*derivativeItem
+		Price *StringFloat64 `json:"price"`
+	}{derivativeItem: (*derivativeItem)(di)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	di.Price = aux.Price.float64Ptr()
+
+	return nil
+}
+
+// DerivativesExchange item in DerivativesExchanges
+type DerivativesExchange struct {
+	ID                     string   `json:"id,omitempty"`
+	Name                   string   `json:"name"`
+	OpenInterestBtc        *float64 `json:"open_interest_btc"`
+	TradeVolume24hBtc      *float64 `json:"trade_volume_24h_btc"`
+	NumberOfPerpetualPairs int      `json:"number_of_perpetual_pairs"`
+	NumberOfFuturesPairs   int      `json:"number_of_futures_pairs"`
+	Image                  string   `json:"image"`
+	YearEstablished        *int     `json:"year_established"`
+	Country                *string  `json:"country"`
+	Description            string   `json:"description"`
+	Url                    string   `json:"url"`
+}
+
+func (de *DerivativesExchange) UnmarshalJSON(data []byte) error {
+	type derivativesExchange DerivativesExchange
+	aux := struct {
+		*derivativesExchange
+		TradeVolume24hBtc *StringFloat64 `json:"trade_volume_24h_btc"`
+	}{derivativesExchange: (*derivativesExchange)(de)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	de.TradeVolume24hBtc = aux.TradeVolume24hBtc.float64Ptr()
+
+	return nil
+}
+
+// DerivativeTickerItem ticker in DerivativesExchangeDetail
+type DerivativeTickerItem struct {
+	Symbol               string             `json:"symbol"`
+	Base                 string             `json:"base"`
+	Target               string             `json:"target"`
+	TradeUrl             string             `json:"trade_url"`
+	ContractType         string             `json:"contract_type"` // perpetual or futures
+	Last                 *float64           `json:"last"`
+	H24PercentageChange  *float64           `json:"h24_percentage_change"`
+	Index                *float64           `json:"index"` // Index price
+	IndexBasisPercentage *float64           `json:"index_basis_percentage"`
+	BidAskSpread         *float64           `json:"bid_ask_spread"`
+	FundingRate          *float64           `json:"funding_rate"`
+	OpenInterestUsd      *float64           `json:"open_interest_usd"`
+	H24Volume            *float64           `json:"h24_volume"`
+	ConvertedVolume      map[string]float64 `json:"converted_volume"`
+	ConvertedLast        map[string]float64 `json:"converted_last"`
+	LastTraded           UnixTime           `json:"last_traded"`
+	ExpiredAt            *UnixTime          `json:"expired_at"` // nil for perpetual contracts
+}
+
+func (dti *DerivativeTickerItem) UnmarshalJSON(data []byte) error {
+	type derivativeTickerItem DerivativeTickerItem
+	aux := struct {
+		*derivativeTickerItem
+		ConvertedVolume map[string]StringFloat64 `json:"converted_volume"`
+		ConvertedLast   map[string]StringFloat64 `json:"converted_last"`
+	}{derivativeTickerItem: (*derivativeTickerItem)(dti)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	dti.ConvertedVolume = toFloat64Map(aux.ConvertedVolume)
+	dti.ConvertedLast = toFloat64Map(aux.ConvertedLast)
+
+	return nil
+}
+
+// NFTsListItem item in NFTsList
+type NFTsListItem struct {
+	ID              string `json:"id"`
+	ContractAddress string `json:"contract_address"`
+	Name            string `json:"name"`
+	AssetPlatformID string `json:"asset_platform_id"`
+	Symbol          string `json:"symbol"`
+}
+
+// NFTValueItem NFT value in the collection native currency (e.g. ETH) and in USD
+type NFTValueItem struct {
+	NativeCurrency *float64 `json:"native_currency"`
+	Usd            *float64 `json:"usd"`
+}
+
+// NFTLinksItem links of a NFT collection
+type NFTLinksItem struct {
+	Homepage string `json:"homepage"`
+	Twitter  string `json:"twitter"`
+	Discord  string `json:"discord"`
+}
+
+// NFTExplorerItem blockchain explorer of a NFT collection
+type NFTExplorerItem struct {
+	Name string `json:"name"`
+	Link string `json:"link"`
+}
+
+// GlobalDecentralizedFinanceDefi for data of /global/decentralized_finance_defi
+type GlobalDecentralizedFinanceDefi struct {
+	BaseResult
+	DefiMarketCap        Decimal `json:"defi_market_cap"`
+	EthMarketCap         Decimal `json:"eth_market_cap"`
+	DefiToEthRatio       Decimal `json:"defi_to_eth_ratio"`
+	TradingVolume24h     Decimal `json:"trading_volume_24h"`
+	DefiDominance        Decimal `json:"defi_dominance"`
+	TopCoinName          string  `json:"top_coin_name"`
+	TopCoinDefiDominance float64 `json:"top_coin_defi_dominance"`
+}
+
+// PublicTreasuryCompanyItem item in CompaniesPublicTreasury
+type PublicTreasuryCompanyItem struct {
+	Name                    string  `json:"name"`
+	Symbol                  string  `json:"symbol"`
+	Country                 string  `json:"country"`
+	TotalHoldings           float64 `json:"total_holdings"`
+	TotalEntryValueUsd      float64 `json:"total_entry_value_usd"`
+	TotalCurrentValueUsd    float64 `json:"total_current_value_usd"`
+	PercentageOfTotalSupply float64 `json:"percentage_of_total_supply"`
+}
+
+// TopGainersLosersItem item in CoinsTopGainersLosers. Price, Volume24h and PriceChangePercentage are in the requested
+// vs currency and duration.
+type TopGainersLosersItem struct {
+	ID                    string   `json:"id"`
+	Symbol                string   `json:"symbol"`
+	Name                  string   `json:"name"`
+	Image                 string   `json:"image"`
+	MarketCapRank         *int     `json:"market_cap_rank"`
+	Price                 *float64 `json:"price"`
+	Volume24h             *float64 `json:"volume24h"`
+	PriceChangePercentage *float64 `json:"priceChangePercentage"`
+}
+
+// CoinsListNewItem item in CoinsListNew
+type CoinsListNewItem struct {
+	ID          string   `json:"id"`
+	Symbol      string   `json:"symbol"`
+	Name        string   `json:"name"`
+	ActivatedAt UnixTime `json:"activated_at"`
+}
+
+// GlobalMarketCapChart for data of /global/market_cap_chart
+type GlobalMarketCapChart struct {
+	BaseResult
+	MarketCap []ChartItem `json:"market_cap"`
+	Volume    []ChartItem `json:"volume"`
 }
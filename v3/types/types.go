@@ -1,8 +1,10 @@
 package types
 
 import (
+	"encoding/json"
 	"math"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -45,12 +47,19 @@ func toMaxAge(value []string) time.Duration {
 
 type BasePageResult struct {
 	BaseResult
-	NextPageIndex     int `json:"nextPageIndex,omitempty"`
-	LastPageIndex     int `json:"lastPageIndex,omitempty"`
-	PageSize          int `json:"pageSize,omitempty"`
-	TotalEntriesCount int `json:"totalEntriesCount,omitempty"`
+	PrevPageIndex     int    `json:"prevPageIndex,omitempty"`
+	NextPageIndex     int    `json:"nextPageIndex,omitempty"`
+	LastPageIndex     int    `json:"lastPageIndex,omitempty"`
+	PageSize          int    `json:"pageSize,omitempty"`
+	TotalEntriesCount int    `json:"totalEntriesCount,omitempty"`
+	FirstPageURL      string `json:"firstPageURL,omitempty"`
+	PrevPageURL       string `json:"prevPageURL,omitempty"`
+	NextPageURL       string `json:"nextPageURL,omitempty"`
+	LastPageURL       string `json:"lastPageURL,omitempty"`
 }
 
+// NewBasePageResult computes the page navigation from the "Link" header (RFC 5988) and the "Total" / "Per-Page"
+// headers. When only one of them is present, the other is used as fallback. Unknown indices are set to -1.
 func NewBasePageResult(header http.Header, currentPageIndex int) BasePageResult {
 	if currentPageIndex < 1 {
 		currentPageIndex = 1
@@ -58,6 +67,7 @@ func NewBasePageResult(header http.Header, currentPageIndex int) BasePageResult
 
 	total := toInt(header["Total"], -1)
 	pageSize := toInt(header["Per-Page"], -1)
+	prevPageIndex := -1
 	lastPageIndex := -1
 	nextPageIndex := -1
 	if total != -1 && pageSize != -1 {
@@ -66,17 +76,106 @@ func NewBasePageResult(header http.Header, currentPageIndex int) BasePageResult
 		if nextPageIndex > lastPageIndex {
 			nextPageIndex = -1
 		}
+		if currentPageIndex > 1 {
+			prevPageIndex = currentPageIndex - 1
+		}
+	}
+
+	links := toLinks(header["Link"])
+	if l, ok := links["prev"]; ok && l.pageIndex != -1 {
+		prevPageIndex = l.pageIndex
+	}
+	if l, ok := links["next"]; ok && l.pageIndex != -1 {
+		nextPageIndex = l.pageIndex
+	}
+	if l, ok := links["last"]; ok && l.pageIndex != -1 {
+		lastPageIndex = l.pageIndex
+	}
+	if pageSize == -1 {
+		for _, l := range links {
+			if l.pageSize != -1 {
+				pageSize = l.pageSize
+				break
+			}
+		}
 	}
 
 	return BasePageResult{
 		BaseResult:        NewBaseResult(header),
+		PrevPageIndex:     prevPageIndex,
 		NextPageIndex:     nextPageIndex,
 		LastPageIndex:     lastPageIndex,
 		PageSize:          pageSize,
 		TotalEntriesCount: total,
+		FirstPageURL:      links["first"].url,
+		PrevPageURL:       links["prev"].url,
+		NextPageURL:       links["next"].url,
+		LastPageURL:       links["last"].url,
 	}
 }
 
+type pageLink struct {
+	url       string
+	pageIndex int
+	pageSize  int
+}
+
+// toLinks parses RFC 5988 "Link" header values, e.g. `<https://...?page=2&per_page=100>; rel="next"`, into a map
+// keyed by relation type.
+func toLinks(values []string) map[string]pageLink {
+	r := make(map[string]pageLink)
+	for _, value := range values {
+		for {
+			start := strings.Index(value, "<")
+			if start == -1 {
+				break
+			}
+			end := strings.Index(value[start:], ">")
+			if end == -1 {
+				break
+			}
+			end += start
+
+			link := toPageLink(value[start+1 : end])
+			value = value[end+1:]
+
+			params := value
+			if next := strings.Index(value, "<"); next != -1 {
+				params = value[:next]
+			}
+			for _, param := range strings.Split(params, ";") {
+				k, v, found := strings.Cut(strings.TrimSpace(param), "=")
+				if !found || !strings.EqualFold(strings.TrimSpace(k), "rel") {
+					continue
+				}
+
+				v = strings.Trim(v, "\" ,")
+				for _, rel := range strings.Fields(v) {
+					r[strings.ToLower(rel)] = link
+				}
+			}
+		}
+	}
+
+	return r
+}
+
+func toPageLink(rawURL string) pageLink {
+	l := pageLink{
+		url:       rawURL,
+		pageIndex: -1,
+		pageSize:  -1,
+	}
+
+	if u, err := url.Parse(rawURL); err == nil {
+		query := u.Query()
+		l.pageIndex = toInt(query["page"], -1)
+		l.pageSize = toInt(query["per_page"], -1)
+	}
+
+	return l
+}
+
 func toInt(value []string, defaultValue int) int {
 	if len(value) >= 1 {
 		if v, err := strconv.Atoi(value[0]); err == nil {
@@ -93,11 +192,66 @@ type Ping struct {
 	GeckoSays string `json:"gecko_says"`
 }
 
+// KeyUsage https://pro-api.coingecko.com/api/v3/key
+type KeyUsage struct {
+	BaseResult
+	Plan                         string `json:"plan"`
+	RateLimitRequestPerMinute    int    `json:"rate_limit_request_per_minute"`
+	MonthlyCallCredit            int64  `json:"monthly_call_credit"`
+	CurrentTotalMonthlyCalls     int64  `json:"current_total_monthly_calls"`
+	CurrentRemainingMonthlyCalls int64  `json:"current_remaining_monthly_calls"`
+}
+
 type SimplePriceCurrencyItem struct {
 	Price               float64  `json:"price,omitempty"`
 	MarketCap           *float64 `json:"marketCap,omitempty"`
 	Volume24H           *float64 `json:"volume24H,omitempty"`
 	ChangePercentage24H *float64 `json:"changePercentage24H,omitempty"`
+
+	// Exact values of Price, MarketCap and Volume24H
+	PriceDecimal     Decimal  `json:"-"`
+	MarketCapDecimal *Decimal `json:"-"`
+	Volume24HDecimal *Decimal `json:"-"`
+}
+
+// UnmarshalJSON decodes Price, MarketCap and Volume24H from their exact Decimal values
+func (spci *SimplePriceCurrencyItem) UnmarshalJSON(data []byte) error {
+	type simplePriceCurrencyItem SimplePriceCurrencyItem
+	aux := struct {
+		*simplePriceCurrencyItem
+		Price     *Decimal `json:"price"`
+		MarketCap *Decimal `json:"marketCap"`
+		Volume24H *Decimal `json:"volume24H"`
+	}{simplePriceCurrencyItem: (*simplePriceCurrencyItem)(spci)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var fc float64Converter
+	spci.PriceDecimal, spci.Price = Decimal{}, fc.value(aux.Price)
+	if aux.Price != nil {
+		spci.PriceDecimal = *aux.Price
+	}
+	spci.MarketCapDecimal, spci.MarketCap = aux.MarketCap, fc.ptr(aux.MarketCap)
+	spci.Volume24HDecimal, spci.Volume24H = aux.Volume24H, fc.ptr(aux.Volume24H)
+
+	return fc.err
+}
+
+// MarshalJSON encodes Price, MarketCap and Volume24H with their exact Decimal values
+func (spci SimplePriceCurrencyItem) MarshalJSON() ([]byte, error) {
+	type simplePriceCurrencyItem SimplePriceCurrencyItem
+	return json.Marshal(struct {
+		simplePriceCurrencyItem
+		Price     interface{} `json:"price,omitempty"`
+		MarketCap interface{} `json:"marketCap,omitempty"`
+		Volume24H interface{} `json:"volume24H,omitempty"`
+	}{
+		simplePriceCurrencyItem: simplePriceCurrencyItem(spci),
+		Price:                   decimalOrFloat(&spci.PriceDecimal, nonZero(spci.Price)),
+		MarketCap:               decimalOrFloat(spci.MarketCapDecimal, spci.MarketCap),
+		Volume24H:               decimalOrFloat(spci.Volume24HDecimal, spci.Volume24H),
+	})
 }
 
 type SimplePriceItem struct {
@@ -111,6 +265,12 @@ type SimplePrice struct {
 	Coins map[string]*SimplePriceItem `json:"coins"`
 }
 
+// SimpleTokenPrice https://api.coingecko.com/api/v3/simple/token_price/ethereum
+type SimpleTokenPrice struct {
+	BaseResult
+	Tokens map[string]*SimplePriceItem `json:"tokens"` // map[lower-cased contract address]SimplePriceItem
+}
+
 // SimpleSupportedVSCurrencies https://api.coingecko.com/api/v3/simple/supported_vs_currencies
 type SimpleSupportedVSCurrencies struct {
 	BaseResult
@@ -123,6 +283,19 @@ type CoinsList struct {
 	Coins []CoinsListItem `json:"coins"`
 }
 
+// CoinsListNew https://pro-api.coingecko.com/api/v3/coins/list/new
+type CoinsListNew struct {
+	BaseResult
+	Coins []CoinsListNewItem `json:"coins"`
+}
+
+// CoinsTopGainersLosers https://pro-api.coingecko.com/api/v3/coins/top_gainers_losers?vs_currency=usd
+type CoinsTopGainersLosers struct {
+	BaseResult
+	TopGainers []TopGainersLosersItem `json:"top_gainers"`
+	TopLosers  []TopGainersLosersItem `json:"top_losers"`
+}
+
 // CoinsMarkets https://api.coingecko.com/api/v3/coins/markets?vs_currency=usd&order=market_cap_desc&per_page=100&page=1&sparkline=false
 type CoinsMarkets struct {
 	BaseResult
@@ -135,6 +308,9 @@ type CoinsID struct {
 	ID                  string              `json:"id"`
 	Symbol              string              `json:"symbol"`
 	Name                string              `json:"name"`
+	AssetPlatformID     *string             `json:"asset_platform_id"`
+	Platforms           map[string]string   `json:"platforms"`        // map[asset_platform_id]contract_address
+	DetailPlatforms     DetailPlatforms     `json:"detail_platforms"` // map[asset_platform_id]DetailPlatformItem
 	BlockTimeInMin      int32               `json:"block_time_in_minutes"`
 	HashingAlgorithm    string              `json:"hashing_algorithm"`
 	Categories          []string            `json:"categories"`
@@ -143,7 +319,7 @@ type CoinsID struct {
 	Links               *LinksItem          `json:"links"`
 	Image               ImageItem           `json:"image"`
 	CountryOrigin       string              `json:"country_origin"`
-	GenesisDate         string              `json:"genesis_date"`
+	GenesisDate         Date                `json:"genesis_date"`
 	MarketCapRank       uint16              `json:"market_cap_rank"`
 	CoinGeckoRank       uint16              `json:"coingecko_rank"`
 	CoinGeckoScore      float64             `json:"coingecko_score"`
@@ -156,7 +332,7 @@ type CoinsID struct {
 	DeveloperData       *DeveloperDataItem  `json:"developer_data"`
 	PublicInterestStats *PublicInterestItem `json:"public_interest_stats"`
 	StatusUpdates       []StatusUpdateItem  `json:"status_updates"`
-	LastUpdated         string              `json:"last_updated"`
+	LastUpdated         DateTime            `json:"last_updated"`
 	Tickers             []TickerItem        `json:"tickers"`
 }
 
@@ -190,36 +366,47 @@ type CoinIDHistoryMarketDataItem struct {
 // CoinsIDMarketChart https://api.coingecko.com/api/v3/coins/bitcoin/market_chart?vs_currency=usd&days=1
 type CoinsIDMarketChart struct {
 	BaseResult
-	Prices       []ChartItem `json:"prices"`
-	MarketCaps   []ChartItem `json:"market_caps"`
-	TotalVolumes []ChartItem `json:"total_volumes"`
+	Granularity  MarketChartGranularity `json:"-"` // Granularity of the data points, either requested or picked by CoinGecko
+	Prices       []ChartItem            `json:"prices"`
+	MarketCaps   []ChartItem            `json:"market_caps"`
+	TotalVolumes []ChartItem            `json:"total_volumes"`
 }
 
-// CoinsIDStatusUpdates
+// CoinsIDCirculatingSupplyChart https://pro-api.coingecko.com/api/v3/coins/bitcoin/circulating_supply_chart?days=1
+type CoinsIDCirculatingSupplyChart struct {
+	BaseResult
+	CirculatingSupply []ChartItem `json:"circulating_supply"`
+}
+
+// CoinsIDTotalSupplyChart https://pro-api.coingecko.com/api/v3/coins/bitcoin/total_supply_chart?days=1
+type CoinsIDTotalSupplyChart struct {
+	BaseResult
+	TotalSupply []ChartItem `json:"total_supply"`
+}
+
+// CoinsIDOHLC https://api.coingecko.com/api/v3/coins/bitcoin/ohlc?vs_currency=usd&days=1
+type CoinsIDOHLC struct {
+	BaseResult
+	Candles []OHLCItem `json:"candles"`
+}
+
+// CoinsCategoriesList https://api.coingecko.com/api/v3/coins/categories/list
+type CoinsCategoriesList struct {
+	BaseResult
+	Categories map[string]string `json:"categories"` // map[category_id]name
+}
+
+// CoinsCategories https://api.coingecko.com/api/v3/coins/categories?order=market_cap_desc
+type CoinsCategories struct {
+	BaseResult
+	Categories []CoinsCategoryItem `json:"categories"`
+}
 
 // CoinsIDContractAddress https://api.coingecko.com/api/v3/coins/{id}/contract/{contract_address}
-// type CoinsIDContractAddress struct {
-// 	ID                  string           `json:"id"`
-// 	Symbol              string           `json:"symbol"`
-// 	Name                string           `json:"name"`
-// 	BlockTimeInMin      uint16           `json:"block_time_in_minutes"`
-// 	Categories          []string         `json:"categories"`
-// 	Localization        LocalizationItem `json:"localization"`
-// 	Description         DescriptionItem  `json:"description"`
-// 	Links               LinksItem        `json:"links"`
-// 	Image               ImageItem        `json:"image"`
-// 	CountryOrigin       string           `json:"country_origin"`
-// 	GenesisDate         string           `json:"genesis_date"`
-// 	ContractAddress     string           `json:"contract_address"`
-// 	MarketCapRank       uint16           `json:"market_cap_rank"`
-// 	CoinGeckoRank       uint16           `json:"coingecko_rank"`
-// 	CoinGeckoScore      float64          `json:"coingecko_score"`
-// 	DeveloperScore      float64          `json:"developer_score"`
-// 	CommunityScore      float64          `json:"community_score"`
-// 	LiquidityScore      float64          `json:"liquidity_score"`
-// 	PublicInterestScore float64          `json:"public_interest_score"`
-// 	MarketData          `json:"market_data"`
-// }
+type CoinsIDContractAddress struct {
+	CoinsID
+	ContractAddress string `json:"contract_address"`
+}
 
 type Exchanges struct {
 	BasePageResult
@@ -281,13 +468,151 @@ type ExchangeTickers struct {
 	Tickers []TickerItem `json:"tickers"`
 }
 
+// Derivatives https://api.coingecko.com/api/v3/derivatives
+type Derivatives struct {
+	BaseResult
+	Derivatives []DerivativeItem `json:"derivatives"`
+}
+
+// DerivativesExchanges https://api.coingecko.com/api/v3/derivatives/exchanges
+type DerivativesExchanges struct {
+	BasePageResult
+	Exchanges []DerivativesExchange `json:"exchanges"`
+}
+
+// DerivativesExchangeDetail https://api.coingecko.com/api/v3/derivatives/exchanges/{id}
+type DerivativesExchangeDetail struct {
+	BaseResult
+	DerivativesExchange
+	Tickers []DerivativeTickerItem `json:"tickers"`
+}
+
+// UnmarshalJSON decodes the fields of the embedded DerivativesExchange, which would otherwise shadow Tickers with
+// its own UnmarshalJSON.
+func (ded *DerivativesExchangeDetail) UnmarshalJSON(data []byte) error {
+	type derivativesExchange DerivativesExchange
+	aux := struct {
+		*derivativesExchange
+		TradeVolume24hBtc *StringFloat64         `json:"trade_volume_24h_btc"`
+		Tickers           []DerivativeTickerItem `json:"tickers"`
+	}{derivativesExchange: (*derivativesExchange)(&ded.DerivativesExchange)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	ded.TradeVolume24hBtc = aux.TradeVolume24hBtc.float64Ptr()
+	ded.Tickers = aux.Tickers
+
+	return nil
+}
+
+// ExchangesIDVolumeChart https://api.coingecko.com/api/v3/exchanges/{id}/volume_chart?days=1
+type ExchangesIDVolumeChart struct {
+	BaseResult
+	Volumes []ChartItem `json:"volumes"` // Trade volume in BTC
+}
+
+// NFTsList https://api.coingecko.com/api/v3/nfts/list
+type NFTsList struct {
+	BasePageResult
+	NFTs []NFTsListItem `json:"nfts"`
+}
+
+// NFTsID https://api.coingecko.com/api/v3/nfts/{id}
+type NFTsID struct {
+	BaseResult
+	ID                                         string            `json:"id"`
+	ContractAddress                            string            `json:"contract_address"`
+	AssetPlatformID                            string            `json:"asset_platform_id"`
+	Name                                       string            `json:"name"`
+	Symbol                                     string            `json:"symbol"`
+	Image                                      ImageItem         `json:"image"`
+	Description                                string            `json:"description"`
+	NativeCurrency                             string            `json:"native_currency"`
+	NativeCurrencySymbol                       string            `json:"native_currency_symbol"`
+	FloorPrice                                 NFTValueItem      `json:"floor_price"`
+	MarketCap                                  NFTValueItem      `json:"market_cap"`
+	Volume24h                                  NFTValueItem      `json:"volume_24h"`
+	FloorPriceInUsd24hPercentageChange         *float64          `json:"floor_price_in_usd_24h_percentage_change"`
+	FloorPrice24hPercentageChange              NFTValueItem      `json:"floor_price_24h_percentage_change"`
+	FloorPrice7dPercentageChange               NFTValueItem      `json:"floor_price_7d_percentage_change"`
+	FloorPrice14dPercentageChange              NFTValueItem      `json:"floor_price_14d_percentage_change"`
+	FloorPrice30dPercentageChange              NFTValueItem      `json:"floor_price_30d_percentage_change"`
+	FloorPrice60dPercentageChange              NFTValueItem      `json:"floor_price_60d_percentage_change"`
+	FloorPrice1yPercentageChange               NFTValueItem      `json:"floor_price_1y_percentage_change"`
+	MarketCap24hPercentageChange               NFTValueItem      `json:"market_cap_24h_percentage_change"`
+	Volume24hPercentageChange                  NFTValueItem      `json:"volume_24h_percentage_change"`
+	NumberOfUniqueAddresses                    *int              `json:"number_of_unique_addresses"` // Holders count
+	NumberOfUniqueAddresses24hPercentageChange *float64          `json:"number_of_unique_addresses_24h_percentage_change"`
+	TotalSupply                                *float64          `json:"total_supply"`
+	OneDaySales                                *float64          `json:"one_day_sales"`
+	OneDayAverageSalePrice                     *float64          `json:"one_day_average_sale_price"`
+	Links                                      NFTLinksItem      `json:"links"`
+	Explorers                                  []NFTExplorerItem `json:"explorers"`
+}
+
+// CompaniesPublicTreasury https://api.coingecko.com/api/v3/companies/public_treasury/bitcoin
+type CompaniesPublicTreasury struct {
+	BaseResult
+	TotalHoldings      float64                     `json:"total_holdings"`
+	TotalValueUsd      float64                     `json:"total_value_usd"`
+	MarketCapDominance float64                     `json:"market_cap_dominance"`
+	Companies          []PublicTreasuryCompanyItem `json:"companies"`
+}
+
 // ExchangeRates https://api.coingecko.com/api/v3/exchange_rates
 type ExchangeRates struct {
 	BaseResult
 	Rates map[string]ExchangeRatesItem `json:"rates"`
 }
 
+// Search https://api.coingecko.com/api/v3/search?query=bitcoin
+type Search struct {
+	BaseResult
+	Coins      []SearchCoinItem     `json:"coins"`
+	Exchanges  []SearchExchangeItem `json:"exchanges"`
+	Categories []SearchCategoryItem `json:"categories"`
+	NFTs       []SearchNFTItem      `json:"nfts"`
+}
+
+// SearchTrending https://api.coingecko.com/api/v3/search/trending
+type SearchTrending struct {
+	BaseResult
+	Coins      []TrendingCoinItem     `json:"coins"`
+	NFTs       []TrendingNFTItem      `json:"nfts"`
+	Categories []TrendingCategoryItem `json:"categories"`
+}
+
+// AssetPlatforms https://api.coingecko.com/api/v3/asset_platforms
+type AssetPlatforms struct {
+	BaseResult
+	Platforms []AssetPlatformItem `json:"platforms"`
+}
+
+// PlatformIDsByChainID maps EVM chain id (e.g. 1, 56, 137) to asset platform id (e.g. ethereum, binance-smart-chain,
+// polygon-pos). Platforms without chain identifier are excluded.
+func (ap AssetPlatforms) PlatformIDsByChainID() map[int64]string {
+	r := make(map[int64]string)
+	for _, p := range ap.Platforms {
+		if p.ChainIdentifier != nil {
+			r[*p.ChainIdentifier] = p.ID
+		}
+	}
+
+	return r
+}
+
 // GlobalResponse https://api.coingecko.com/api/v3/global
 type GlobalResponse struct {
 	Data *Global `json:"data"`
 }
+
+// GlobalDecentralizedFinanceDefiResponse https://api.coingecko.com/api/v3/global/decentralized_finance_defi
+type GlobalDecentralizedFinanceDefiResponse struct {
+	Data *GlobalDecentralizedFinanceDefi `json:"data"`
+}
+
+// GlobalMarketCapChartResponse https://pro-api.coingecko.com/api/v3/global/market_cap_chart?days=1
+type GlobalMarketCapChartResponse struct {
+	Data *GlobalMarketCapChart `json:"market_cap_chart"`
+}
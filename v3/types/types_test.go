@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"testing"
@@ -101,6 +102,96 @@ func TestNewBasePageResult(t *testing.T) {
 	}
 }
 
+func TestNewBasePageResult_link(t *testing.T) {
+	const (
+		first = "https://api.coingecko.com/api/v3/exchanges?page=1&per_page=100"
+		prev  = "https://api.coingecko.com/api/v3/exchanges?page=1&per_page=100"
+		next  = "https://api.coingecko.com/api/v3/exchanges?page=3&per_page=100"
+		last  = "https://api.coingecko.com/api/v3/exchanges?page=63&per_page=100"
+	)
+	link := arrStrings("<" + first + `>; rel="first", <` + prev + `>; rel="prev", <` + next + `>; rel="next", <` + last + `>; rel="last"`)
+
+	t.Run("link only", func(t *testing.T) {
+		got := NewBasePageResult(http.Header{"Link": link}, 2)
+
+		assert.Equal(t, 1, got.PrevPageIndex)
+		assert.Equal(t, 3, got.NextPageIndex)
+		assert.Equal(t, 63, got.LastPageIndex)
+		assert.Equal(t, 100, got.PageSize)
+		assert.Equal(t, -1, got.TotalEntriesCount)
+		assert.Equal(t, first, got.FirstPageURL)
+		assert.Equal(t, prev, got.PrevPageURL)
+		assert.Equal(t, next, got.NextPageURL)
+		assert.Equal(t, last, got.LastPageURL)
+	})
+
+	t.Run("total only", func(t *testing.T) {
+		got := NewBasePageResult(http.Header{
+			"Per-Page": arrStrings("100"),
+			"Total":    arrStrings("6247"),
+		}, 2)
+
+		assert.Equal(t, 1, got.PrevPageIndex)
+		assert.Equal(t, 3, got.NextPageIndex)
+		assert.Equal(t, 63, got.LastPageIndex)
+		assert.Equal(t, 100, got.PageSize)
+		assert.Equal(t, 6247, got.TotalEntriesCount)
+		assert.Empty(t, got.NextPageURL)
+	})
+
+	t.Run("last page", func(t *testing.T) {
+		got := NewBasePageResult(http.Header{
+			"Link": arrStrings(`<` + first + `>; rel="first", <` + last + `>; rel="prev"`),
+		}, 64)
+
+		assert.Equal(t, 63, got.PrevPageIndex)
+		assert.Equal(t, -1, got.NextPageIndex)
+		assert.Equal(t, -1, got.LastPageIndex)
+		assert.Empty(t, got.NextPageURL)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		got := NewBasePageResult(http.Header{}, 1)
+
+		assert.Equal(t, -1, got.PrevPageIndex)
+		assert.Equal(t, -1, got.NextPageIndex)
+		assert.Equal(t, -1, got.LastPageIndex)
+		assert.Equal(t, -1, got.PageSize)
+	})
+}
+
+func Test_toLinks(t *testing.T) {
+	tests := []struct {
+		name  string
+		value []string
+		want  map[string]pageLink
+	}{
+		{"invalid: nil", nil, map[string]pageLink{}},
+		{"invalid: not a link", arrStrings("whatever"), map[string]pageLink{}},
+		{
+			"valid: multiple rel",
+			arrStrings(`<https://x.io/a?page=2>; rel="next last"`),
+			map[string]pageLink{
+				"next": {"https://x.io/a?page=2", 2, -1},
+				"last": {"https://x.io/a?page=2", 2, -1},
+			},
+		},
+		{
+			"valid: multiple values",
+			arrStrings(`<https://x.io/a?page=1&per_page=5>; rel=prev`, `<https://x.io/a?page=3&per_page=5>; rel="next"`),
+			map[string]pageLink{
+				"prev": {"https://x.io/a?page=1&per_page=5", 1, 5},
+				"next": {"https://x.io/a?page=3&per_page=5", 3, 5},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equalf(t, tt.want, toLinks(tt.value), "toLinks(%v)", tt.value)
+		})
+	}
+}
+
 func Test_toInt(t *testing.T) {
 	type args struct {
 		value        []string
@@ -123,3 +214,138 @@ func Test_toInt(t *testing.T) {
 		})
 	}
 }
+
+func TestStringFloat64_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    StringFloat64
+		wantErr bool
+	}{
+		{"valid: number", `123.45`, 123.45, false},
+		{"valid: string", `"574366.94"`, 574366.94, false},
+		{"valid: null", `null`, 0, false},
+		{"valid: empty string", `""`, 0, false},
+		{"invalid: not a number", `"abc"`, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got StringFloat64
+			err := got.UnmarshalJSON([]byte(tt.data))
+
+			assert.Equal(t, tt.wantErr, err != nil, "UnmarshalJSON(%s) = %v", tt.data, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUnixTime_JSON(t *testing.T) {
+	var got UnixTime
+	assert.NoError(t, got.UnmarshalJSON([]byte(`1680249600`)))
+	assert.Equal(t, time.Date(2023, time.March, 31, 8, 0, 0, 0, time.UTC), got.Time)
+
+	ba, err := got.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `1680249600`, string(ba))
+
+	assert.NoError(t, got.UnmarshalJSON([]byte(`null`)))
+	assert.True(t, got.IsZero())
+}
+
+func TestDateTime_JSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		want     time.Time
+		wantJSON string
+		wantErr  bool
+	}{
+		{"valid: utc", `"2021-11-10T14:24:11.849Z"`, time.Date(2021, time.November, 10, 14, 24, 11, 849000000, time.UTC), `"2021-11-10T14:24:11.849Z"`, false},
+		{"valid: offset", `"2023-01-11T20:44:11+08:00"`, time.Date(2023, time.January, 11, 12, 44, 11, 0, time.UTC), `"2023-01-11T12:44:11Z"`, false},
+		{"valid: date only", `"2013-12-08"`, time.Date(2013, time.December, 8, 0, 0, 0, 0, time.UTC), `"2013-12-08T00:00:00Z"`, false},
+		{"valid: null", `null`, time.Time{}, `null`, false},
+		{"valid: empty", `""`, time.Time{}, `null`, false},
+		{"invalid", `"yesterday"`, time.Time{}, `null`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got DateTime
+			err := json.Unmarshal([]byte(tt.data), &got)
+			assert.Equal(t, tt.wantErr, err != nil, "Unmarshal(%s) = %v", tt.data, err)
+			assert.Equal(t, tt.want, got.Time)
+
+			ba, err := json.Marshal(got)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantJSON, string(ba))
+		})
+	}
+}
+
+func TestDate_JSON(t *testing.T) {
+	var got Date
+	assert.NoError(t, json.Unmarshal([]byte(`"2013-12-08"`), &got))
+	assert.Equal(t, time.Date(2013, time.December, 8, 0, 0, 0, 0, time.UTC), got.Time)
+
+	ba, err := json.Marshal(got)
+	assert.NoError(t, err)
+	assert.Equal(t, `"2013-12-08"`, string(ba))
+
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &got))
+	assert.True(t, got.IsZero())
+
+	ba, err = json.Marshal(got)
+	assert.NoError(t, err)
+	assert.Equal(t, `null`, string(ba))
+}
+
+func TestMarketDataItem_roundTrip(t *testing.T) {
+	data := `{"current_price":{"eth":0.000748170000000001234,"usd":1.001,"xdr":null},"total_volume":{"usd":"2868392910.428337"},` +
+		`"ath_date":{"usd":"2021-11-10T14:24:11.849Z"},"atl_date":{"usd":null},"last_updated":""}`
+
+	var got MarketDataItem
+	assert.NoError(t, json.Unmarshal([]byte(data), &got))
+	assert.Equal(t, time.Date(2021, time.November, 10, 14, 24, 11, 849000000, time.UTC), got.ATHDate["usd"].Time)
+	assert.True(t, got.ATLDate["usd"].IsZero(), "got.ATLDate[usd]")
+	assert.True(t, got.LastUpdated.IsZero(), "got.LastUpdated")
+	assert.Equal(t, AllCurrencies{"eth": 0.0007481700000000012, "usd": 1.001, "xdr": 0}, got.CurrentPrice, "got.CurrentPrice")
+	assert.NotContains(t, got.CurrentPriceDecimal, "xdr", "got.CurrentPriceDecimal leaves out null")
+	assert.Nil(t, got.MarketCapDecimal, "got.MarketCapDecimal")
+
+	ba, err := json.Marshal(got)
+	assert.NoError(t, err)
+	assert.Contains(t, string(ba), `"eth":0.000748170000000001234`)
+	assert.Contains(t, string(ba), `"xdr":null`)
+
+	var again MarketDataItem
+	assert.NoError(t, json.Unmarshal(ba, &again))
+	assert.Equal(t, got, again)
+	assert.Equal(t, "0.000748170000000001234", again.CurrentPriceDecimal["eth"].String(), "again.CurrentPriceDecimal[eth]")
+	assert.Equal(t, "2868392910.428337", again.TotalVolumeDecimal["usd"].String(), "again.TotalVolumeDecimal[usd]")
+}
+
+func TestCoinsCategoryItem_roundTrip(t *testing.T) {
+	var got CoinsCategoryItem
+	assert.NoError(t, json.Unmarshal([]byte(`{"id":"layer-1","updated_at":""}`), &got))
+	assert.True(t, got.UpdatedAt.IsZero(), "got.UpdatedAt")
+
+	ba, err := json.Marshal(got)
+	assert.NoError(t, err)
+	assert.Contains(t, string(ba), `"updated_at":null`)
+
+	var again CoinsCategoryItem
+	assert.NoError(t, json.Unmarshal(ba, &again))
+	assert.Equal(t, got, again)
+}
+
+func TestSortUniqueChartItems(t *testing.T) {
+	t0 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	items := []ChartItem{{t0.Add(time.Hour), 2}, {t0, 1}, {t0.Add(time.Hour), 3}, {t0.Add(5 * time.Minute), 4}}
+	original := append([]ChartItem(nil), items...)
+
+	got := SortUniqueChartItems(items)
+	sorted := []ChartItem{{t0, 1}, {t0.Add(5 * time.Minute), 4}, {t0.Add(time.Hour), 2}}
+	assert.Equal(t, sorted, got)
+	assert.Equal(t, []ChartItem{{t0, 1}, {t0.Add(time.Hour), 2}}, ResampleChartItems(got, time.Hour))
+	assert.Equal(t, sorted, got, "ResampleChartItems leaves its input unchanged")
+	assert.Equal(t, original, items, "SortUniqueChartItems leaves its input unchanged")
+}
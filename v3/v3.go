@@ -2,7 +2,9 @@ package coingecko
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"github.com/edward-yakop/go-gecko/v3/internal"
 	"io"
 	"net/http"
 	"os"
@@ -11,6 +13,8 @@ import (
 
 var baseURL = "https://api.coingecko.com/api/v3"
 
+var proBaseURL = "https://pro-api.coingecko.com/api/v3"
+
 type HttpRequestModifier func(r *http.Request)
 
 // Client struct
@@ -18,6 +22,7 @@ type Client struct {
 	httpClient          *http.Client
 	baseURL             string
 	httpRequestModifier HttpRequestModifier
+	isPro               bool
 }
 
 type ClientOption func(client *Client)
@@ -28,9 +33,19 @@ func WithHttpRequestModifier(f HttpRequestModifier) ClientOption {
 	}
 }
 
+// WithProPlan targets the paid plan API and enables the endpoints that are only available to paid plan subscribers.
+// The API key must be set by the caller, e.g. through WithHttpRequestModifier; see WithAPIKey.
+func WithProPlan() ClientOption {
+	return func(c *Client) {
+		c.baseURL = proBaseURL
+		c.isPro = true
+	}
+}
+
+// WithAPIKey targets the paid plan API (see WithProPlan), authenticating with apiKey
 func WithAPIKey(apiKey string) ClientOption {
 	return func(c *Client) {
-		c.baseURL = "https://pro-api.coingecko.com/api/v3"
+		WithProPlan()(c)
 		c.httpRequestModifier = func(r *http.Request) {
 			r.Header.Set("x-cg-pro-api-key", apiKey)
 		}
@@ -55,6 +70,110 @@ func NewClient(httpClient *http.Client, options ...ClientOption) *Client {
 	return c
 }
 
+// ResponseError is returned when CoinGecko responds with a non 200 status code. It matches ErrInvalidAPIKey and
+// ErrProPlanRequired with errors.Is when CoinGecko's error code says so.
+type ResponseError struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+func (e *ResponseError) Error() string {
+	return string(e.Body)
+}
+
+func (e *ResponseError) Is(target error) bool {
+	switch target {
+	case ErrInvalidAPIKey:
+		return e.isInvalidAPIKey()
+	case ErrProPlanRequired:
+		return e.isPlanRestricted()
+	default:
+		return false
+	}
+}
+
+// CoinGecko error codes of the error status
+const (
+	errorCodeAPIKeyMissing     = 10002
+	errorCodePlanRestricted    = 10005
+	errorCodeInvalidProAPIKey  = 10010
+	errorCodeInvalidDemoAPIKey = 10011
+)
+
+// errorStatus status of CoinGecko error body
+type errorStatus struct {
+	ErrorCode    int    `json:"error_code"`
+	ErrorMessage string `json:"error_message"`
+}
+
+// status decodes the error status of the body, either {"status":{...}} or {"error":{"status":{...}}}. The zero value
+// is returned when the body has none.
+func (e *ResponseError) status() errorStatus {
+	var body struct {
+		Status errorStatus     `json:"status"`
+		Error  json.RawMessage `json:"error"`
+	}
+	if err := json.Unmarshal(e.Body, &body); err != nil {
+		return errorStatus{}
+	}
+
+	if body.Status.ErrorCode == 0 && len(body.Error) > 0 && body.Error[0] == '{' {
+		var nested struct {
+			Status errorStatus `json:"status"`
+		}
+		if err := json.Unmarshal(body.Error, &nested); err != nil {
+			return errorStatus{}
+		}
+
+		return nested.Status
+	}
+
+	return body.Status
+}
+
+func (e *ResponseError) isInvalidAPIKey() bool {
+	switch e.status().ErrorCode {
+	case errorCodeAPIKeyMissing, errorCodeInvalidProAPIKey, errorCodeInvalidDemoAPIKey:
+		return true
+	default:
+		return false
+	}
+}
+
+func (e *ResponseError) isPlanRestricted() bool {
+	status := e.status()
+
+	return status.ErrorCode == errorCodePlanRestricted || strings.Contains(status.ErrorMessage, "Pro API subscribers")
+}
+
+// ErrProPlanRequired is returned when calling an endpoint that is only available to paid plan subscribers, either
+// without a paid plan client (see WithProPlan and WithAPIKey) or with a key whose plan does not include the endpoint.
+var ErrProPlanRequired = errors.New("endpoint requires a CoinGecko paid plan API key")
+
+// ErrInvalidAPIKey is matched by the ResponseError returned when CoinGecko rejects the API key as missing or invalid
+var ErrInvalidAPIKey = errors.New("missing or invalid CoinGecko API key")
+
+// requirePro returns ErrProPlanRequired when the client is not configured for the paid plan
+func (c *Client) requirePro(endpoint string) error {
+	if !c.isPro {
+		return fmt.Errorf("%w: %s", ErrProPlanRequired, endpoint)
+	}
+
+	return nil
+}
+
+// toPlanRestrictedError maps CoinGecko's plan restriction response to ErrProPlanRequired. Other errors, including a
+// rejected API key, are returned as is.
+func toPlanRestrictedError(err error, endpoint string) error {
+	var rErr *ResponseError
+	if errors.As(err, &rErr) && rErr.isPlanRestricted() {
+		return fmt.Errorf("%w: %s: %s", ErrProPlanRequired, endpoint, rErr.Body)
+	}
+
+	return err
+}
+
 // helper
 // doReq HTTP client
 func doReq(req *http.Request, client *http.Client) ([]byte, http.Header, error) {
@@ -73,7 +192,11 @@ func doReq(req *http.Request, client *http.Client) ([]byte, http.Header, error)
 	}
 
 	if http.StatusOK != resp.StatusCode {
-		return nil, nil, fmt.Errorf("%s", body)
+		return nil, nil, &ResponseError{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header,
+			Body:       body,
+		}
 	}
 
 	//dumpResponse(resp, body)
@@ -122,6 +245,14 @@ func (c *Client) makeHTTPRequest(url string) ([]byte, http.Header, error) {
 	return resp, header, err
 }
 
+// init shares makeHTTPRequest with sibling packages, such as onchain, through internal.Get
+func init() {
+	internal.Get = func(client interface{}, path string) ([]byte, http.Header, error) {
+		c := client.(*Client)
+		return c.makeHTTPRequest(c.baseURL + path)
+	}
+}
+
 func firstError(fst, snd error) error {
 	if fst != nil {
 		return fst
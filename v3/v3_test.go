@@ -17,15 +17,32 @@ func init() {
 var c = NewClient(nil)
 var mockURL = "https://api.coingecko.com/api/v3"
 
+var proC = NewClient(nil, WithAPIKey("pro-api-key"))
+var proMockURL = "https://pro-api.coingecko.com/api/v3"
+
 // Util: Setup Gock
 func setupGock(bodyFileName, headerFileName, url string) error {
+	return setupGockWithBaseURL(mockURL, bodyFileName, headerFileName, url)
+}
+
+// Util: Setup Gock for pro API, also asserting the API key header
+func setupProGock(bodyFileName, headerFileName, url string) error {
+	return setupGockWithBaseURL(proMockURL, bodyFileName, headerFileName, url)
+}
+
+func setupGockWithBaseURL(baseURL, bodyFileName, headerFileName, url string) error {
 	bodyBA, err := os.ReadFile(bodyFileName)
 	if err != nil {
 		return fmt.Errorf("fail to read %s: %v", bodyFileName, err)
 	}
 
-	g := gock.New(mockURL).
-		Get(url).
+	req := gock.New(baseURL).
+		Get(url)
+	if baseURL == proMockURL {
+		req = req.MatchHeader("x-cg-pro-api-key", "pro-api-key")
+	}
+
+	g := req.
 		Reply(http.StatusOK).
 		JSON(bodyBA)
 
@@ -42,6 +59,22 @@ func setupGock(bodyFileName, headerFileName, url string) error {
 	return nil
 }
 
+// Util: Setup Gock to reply with a non 200 status code
+func setupGockError(url string, statusCode int, body string) {
+	gock.New(mockURL).
+		Get(url).
+		Reply(statusCode).
+		BodyString(body)
+}
+
+// Util: Setup Gock for pro API to reply with a non 200 status code
+func setupProGockError(url string, statusCode int, body string) {
+	gock.New(proMockURL).
+		Get(url).
+		Reply(statusCode).
+		BodyString(body)
+}
+
 func secs(i time.Duration) time.Duration {
 	return time.Second * i
 }
@@ -55,9 +88,10 @@ func baseResult(maxAge time.Duration, expires time.Time) types.BaseResult {
 
 var commonBaseResult = baseResult(120, time.Date(2023, time.January, 11, 12, 44, 47, 0, time.UTC))
 
-func basePageResult(maxAge time.Duration, expires time.Time, nextPageIndex, lastPageIndex, pageSize, totalEntriesCount int) types.BasePageResult {
+func basePageResult(maxAge time.Duration, expires time.Time, prevPageIndex, nextPageIndex, lastPageIndex, pageSize, totalEntriesCount int) types.BasePageResult {
 	return types.BasePageResult{
 		BaseResult:        baseResult(maxAge, expires),
+		PrevPageIndex:     prevPageIndex,
 		NextPageIndex:     nextPageIndex,
 		LastPageIndex:     lastPageIndex,
 		PageSize:          pageSize,
@@ -67,5 +101,5 @@ func basePageResult(maxAge time.Duration, expires time.Time, nextPageIndex, last
 
 var commonBasePageResult = basePageResult(
 	120, time.Date(2023, time.January, 11, 12, 44, 47, 0, time.UTC),
-	2, 63, 100, 6247,
+	-1, 2, 63, 100, 6247,
 )